@@ -0,0 +1,190 @@
+package sqsclient
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BackendSelection is the strategy used to pick the SQS base URL for a request
+// when the client has failover URLs.
+type BackendSelection int
+
+const (
+	// SelectPriority picks healthy base URLs in the order they were configured,
+	// the primary URL first.
+	SelectPriority BackendSelection = iota
+	// SelectRoundRobin spreads requests across healthy base URLs.
+	SelectRoundRobin
+	// SelectLowestLatency picks the healthy base URL with the lowest observed latency.
+	SelectLowestLatency
+)
+
+// DefaultBackendCoolDown is how long a base URL is skipped after a connection error or 5xx response.
+const DefaultBackendCoolDown = 30 * time.Second
+
+// latencyEWMAWeight is the weight of the latest observation in the latency moving average.
+const latencyEWMAWeight = 0.2
+
+// backend is an SQS base URL and its health.
+type backend struct {
+	url            string
+	unhealthyUntil time.Time
+	latency        time.Duration
+}
+
+// backends tracks the health of the SQS base URLs and orders them for each request.
+type backends struct {
+	mu sync.Mutex
+
+	selection BackendSelection
+	coolDown  time.Duration
+	list      []*backend
+	next      int
+}
+
+func newBackends(urls []string, selection BackendSelection, coolDown time.Duration) *backends {
+	list := make([]*backend, 0, len(urls))
+	for _, url := range urls {
+		list = append(list, &backend{url: strings.TrimSuffix(url, "/")})
+	}
+
+	return &backends{
+		selection: selection,
+		coolDown:  coolDown,
+		list:      list,
+	}
+}
+
+// urls returns the configured base URLs in priority order.
+func (b *backends) urls() []string {
+	urls := make([]string, 0, len(b.list))
+	for _, backend := range b.list {
+		urls = append(urls, backend.url)
+	}
+	return urls
+}
+
+// order returns the backends to try for a request. Healthy backends come first,
+// ordered by the selection strategy, followed by unhealthy ones as a last resort,
+// soonest to recover first.
+func (b *backends) order() []*backend {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+
+	healthy := make([]*backend, 0, len(b.list))
+	unhealthy := make([]*backend, 0)
+	for _, backend := range b.list {
+		if now.Before(backend.unhealthyUntil) {
+			unhealthy = append(unhealthy, backend)
+		} else {
+			healthy = append(healthy, backend)
+		}
+	}
+
+	switch b.selection {
+	case SelectRoundRobin:
+		if len(healthy) > 0 {
+			start := b.next % len(healthy)
+			b.next++
+			healthy = slices.Concat(healthy[start:], healthy[:start])
+		}
+	case SelectLowestLatency:
+		// Backends without observations have zero latency, so they get probed first.
+		slices.SortStableFunc(healthy, func(a, b *backend) int {
+			return cmp.Compare(a.latency, b.latency)
+		})
+	}
+
+	slices.SortStableFunc(unhealthy, func(a, b *backend) int {
+		return a.unhealthyUntil.Compare(b.unhealthyUntil)
+	})
+
+	return append(healthy, unhealthy...)
+}
+
+// reportSuccess marks the backend healthy and records the latency of the request.
+func (b *backends) reportSuccess(backend *backend, latency time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	backend.unhealthyUntil = time.Time{}
+	if backend.latency == 0 {
+		backend.latency = latency
+	} else {
+		backend.latency = time.Duration(latencyEWMAWeight*float64(latency) + (1-latencyEWMAWeight)*float64(backend.latency))
+	}
+}
+
+// reportFailure marks the backend unhealthy for the cool-down.
+func (b *backends) reportFailure(backend *backend) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	backend.unhealthyUntil = time.Now().Add(b.coolDown)
+}
+
+// isFailoverError returns true if the request should be tried against the next backend.
+// Connection errors and 5xx responses fail over, while client errors such as
+// 4xx responses would fail the same way on every backend.
+func isFailoverError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var requestErr *RequestError
+	if errors.As(err, &requestErr) {
+		return true
+	}
+
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= http.StatusInternalServerError
+}
+
+// WithFailover is a helper function to add failover base URLs to the sqs client,
+// tried in order after the primary URL, and to set how a base URL is picked for each request.
+// A base URL that fails with a connection error or 5xx response is skipped for the cool-down.
+func WithFailover(urls []string, selection BackendSelection, coolDown time.Duration, sqs *sqs) *sqs {
+	sqs.backends = newBackends(append(sqs.backends.urls(), urls...), selection, coolDown)
+	return sqs
+}
+
+// doGetWithFailover calls doGet against the backends in order until one succeeds
+// or fails with an error that would not be fixed by another backend.
+// The order is rotated by the given number of backends, which lets hedged
+// requests start from an alternate backend.
+func (o *sqs) doGetWithFailover(ctx context.Context, endpoint string, path string, rotate int) (httpResponse, error) {
+	order := o.backends.order()
+	if start := rotate % len(order); start > 0 {
+		order = slices.Concat(order[start:], order[:start])
+	}
+
+	var err error
+	for _, backend := range order {
+		var body []byte
+		start := time.Now()
+
+		requestURL := fmt.Sprintf("%s/%s", backend.url, path)
+		body, err = o.doGet(ctx, endpoint, requestURL)
+		if err == nil {
+			o.backends.reportSuccess(backend, time.Since(start))
+			return httpResponse{body: body, url: requestURL}, nil
+		}
+
+		if !isFailoverError(ctx, err) {
+			return httpResponse{}, err
+		}
+
+		o.backends.reportFailure(backend)
+	}
+
+	return httpResponse{}, err
+}
@@ -0,0 +1,144 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+// newCountingServer returns a server that always responds with the given status code.
+func newCountingServer(t *testing.T, statusCode int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestFailover_ServerError(t *testing.T) {
+	primary, primaryCalls := newCountingServer(t, http.StatusBadGateway)
+	secondary, secondaryCalls := newCountingServer(t, http.StatusOK)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(primary.URL), sqsclient.WithFailoverURLsOpt(secondary.URL))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = sqs.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), primaryCalls.Load())
+	require.Equal(t, int32(1), secondaryCalls.Load())
+
+	// The primary is skipped while it is unhealthy.
+	_, err = sqs.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), primaryCalls.Load())
+	require.Equal(t, int32(2), secondaryCalls.Load())
+}
+
+func TestFailover_DecodeErrorURL(t *testing.T) {
+	primary, _ := newCountingServer(t, http.StatusBadGateway)
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not json"))
+	}))
+	defer secondary.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(primary.URL), sqsclient.WithFailoverURLsOpt(secondary.URL))
+	require.NoError(t, err)
+
+	// The error points to the backend that served the response.
+	_, err = sqs.GetTokensMetadata(context.Background())
+	var decodeErr *sqsclient.DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	require.True(t, strings.HasPrefix(decodeErr.URL, secondary.URL+"/"), decodeErr.URL)
+}
+
+func TestFailover_ConnectionError(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	primary.Close()
+
+	secondary, secondaryCalls := newCountingServer(t, http.StatusOK)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(primary.URL), sqsclient.WithFailoverURLsOpt(secondary.URL))
+	require.NoError(t, err)
+
+	_, err = sqs.GetTokensMetadata(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int32(1), secondaryCalls.Load())
+}
+
+func TestFailover_ClientErrorDoesNotFailOver(t *testing.T) {
+	primary, primaryCalls := newCountingServer(t, http.StatusBadRequest)
+	secondary, secondaryCalls := newCountingServer(t, http.StatusOK)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(primary.URL), sqsclient.WithFailoverURLsOpt(secondary.URL))
+	require.NoError(t, err)
+
+	_, err = sqs.GetTokensMetadata(context.Background())
+	require.ErrorIs(t, err, sqsclient.ErrBadRequest)
+	require.Equal(t, int32(1), primaryCalls.Load())
+	require.Equal(t, int32(0), secondaryCalls.Load())
+}
+
+func TestFailover_AllUnhealthy(t *testing.T) {
+	primary, primaryCalls := newCountingServer(t, http.StatusServiceUnavailable)
+	secondary, secondaryCalls := newCountingServer(t, http.StatusServiceUnavailable)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(primary.URL), sqsclient.WithFailoverURLsOpt(secondary.URL))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = sqs.GetTokensMetadata(ctx)
+	require.ErrorIs(t, err, sqsclient.ErrServerError)
+
+	// Unhealthy backends are still tried as a last resort.
+	_, err = sqs.GetTokensMetadata(ctx)
+	require.ErrorIs(t, err, sqsclient.ErrServerError)
+	require.Equal(t, int32(2), primaryCalls.Load())
+	require.Equal(t, int32(2), secondaryCalls.Load())
+}
+
+func TestFailover_RoundRobin(t *testing.T) {
+	primary, primaryCalls := newCountingServer(t, http.StatusOK)
+	secondary, secondaryCalls := newCountingServer(t, http.StatusOK)
+
+	sqs, err := sqsclient.Initialize(
+		sqsclient.WithCustomURL(primary.URL),
+		sqsclient.WithFailoverURLsOpt(secondary.URL),
+		sqsclient.WithBackendSelectionOpt(sqsclient.SelectRoundRobin),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		_, err = sqs.GetTokensMetadata(context.Background())
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int32(2), primaryCalls.Load())
+	require.Equal(t, int32(2), secondaryCalls.Load())
+}
+
+func TestFailover_InvalidOptions(t *testing.T) {
+	_, err := sqsclient.Initialize(sqsclient.WithFailoverEnvironmentsOpt("unknown"))
+	require.Error(t, err)
+
+	_, err = sqsclient.Initialize(sqsclient.WithBackendSelectionOpt(sqsclient.BackendSelection(10)))
+	require.Error(t, err)
+
+	_, err = sqsclient.Initialize(sqsclient.WithFailoverEnvironmentsOpt(sqsclient.Stage), sqsclient.WithFailoverURLsOpt("https://sqs.example.com"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,83 @@
+package sqsclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OsmosisBech32Prefix is the bech32 human-readable prefix of Osmosis account addresses.
+const OsmosisBech32Prefix = "osmo"
+
+// bech32Charset is the bech32 data character set.
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// bech32ChecksumLength is the number of data characters used by the checksum.
+const bech32ChecksumLength = 6
+
+// ValidateBech32Address validates that the address is a well-formed bech32 string,
+// including its checksum, with the given human-readable prefix, e.g. "osmo".
+func ValidateBech32Address(address string, prefix string) error {
+	if address == "" {
+		return fmt.Errorf("address is required")
+	}
+
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return fmt.Errorf("address %q has mixed case", address)
+	}
+	address = strings.ToLower(address)
+
+	separator := strings.LastIndexByte(address, '1')
+	if separator < 1 || separator+bech32ChecksumLength+1 > len(address) {
+		return fmt.Errorf("address %q is not a valid bech32 string", address)
+	}
+
+	hrp, data := address[:separator], address[separator+1:]
+	if hrp != prefix {
+		return fmt.Errorf("address %q must have prefix %q, got %q", address, prefix, hrp)
+	}
+
+	values := make([]byte, 0, len(data))
+	for _, c := range data {
+		value := strings.IndexRune(bech32Charset, c)
+		if value < 0 {
+			return fmt.Errorf("address %q has invalid character %q", address, c)
+		}
+		values = append(values, byte(value))
+	}
+
+	if bech32Polymod(append(bech32ExpandHRP(hrp), values...)) != 1 {
+		return fmt.Errorf("address %q has an invalid checksum", address)
+	}
+
+	return nil
+}
+
+// bech32ExpandHRP expands the human-readable part for checksum computation.
+func bech32ExpandHRP(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// bech32Polymod computes the bech32 checksum polynomial over the values.
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+	checksum := uint32(1)
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ uint32(value)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+	return checksum
+}
@@ -0,0 +1,37 @@
+package sqsclient_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestValidateBech32Address(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		prefix  string
+		wantErr bool
+	}{
+		{name: "valid", address: osmoAddress, prefix: sqsclient.OsmosisBech32Prefix},
+		{name: "valid upper case", address: "OSMO1YS0E5E5ET3ERQADKXJKZ7S9MA60JQK9U9YDXKP", prefix: sqsclient.OsmosisBech32Prefix},
+		{name: "empty", address: "", prefix: sqsclient.OsmosisBech32Prefix, wantErr: true},
+		{name: "wrong prefix", address: "cosmos1ys0e5e5et3erqadkxjkz7s9ma60jqk9udl7kqn", prefix: sqsclient.OsmosisBech32Prefix, wantErr: true},
+		{name: "bad checksum", address: "osmo1ys0e5e5et3erqadkxjkz7s9ma60jqk9u9ydxkq", prefix: sqsclient.OsmosisBech32Prefix, wantErr: true},
+		{name: "mixed case", address: "osmo1YS0e5e5et3erqadkxjkz7s9ma60jqk9u9ydxkp", prefix: sqsclient.OsmosisBech32Prefix, wantErr: true},
+		{name: "invalid character", address: "osmo1ys0e5e5et3erqadkxjkz7s9ma60jqk9u9ydxkb", prefix: sqsclient.OsmosisBech32Prefix, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := sqsclient.ValidateBech32Address(tc.address, tc.prefix)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
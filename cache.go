@@ -0,0 +1,100 @@
+package sqsclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// CacheStats counts the lookups of a cache. It is safe for concurrent use.
+type CacheStats struct {
+	hits          atomic.Uint64
+	staleHits     atomic.Uint64
+	misses        atomic.Uint64
+	refreshes     atomic.Uint64
+	refreshErrors atomic.Uint64
+}
+
+// Hits returns the number of lookups answered from the cache with a fresh value.
+func (s *CacheStats) Hits() uint64 {
+	return s.hits.Load()
+}
+
+// StaleHits returns the number of lookups answered from the cache with a stale value
+// while it was refreshed in the background.
+func (s *CacheStats) StaleHits() uint64 {
+	return s.staleHits.Load()
+}
+
+// Misses returns the number of lookups that had to wait for SQS.
+func (s *CacheStats) Misses() uint64 {
+	return s.misses.Load()
+}
+
+// Refreshes returns the number of background refreshes.
+func (s *CacheStats) Refreshes() uint64 {
+	return s.refreshes.Load()
+}
+
+// RefreshErrors returns the number of background refreshes that failed.
+func (s *CacheStats) RefreshErrors() uint64 {
+	return s.refreshErrors.Load()
+}
+
+// flightGroup deduplicates concurrent calls with the same key: while a call is in flight,
+// later calls with the same key wait for it and share its result.
+type flightGroup[V any] struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall[V]
+}
+
+// flightCall is a call in flight of a flightGroup.
+type flightCall[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// do calls fn in the background unless a call with the same key is in flight, then waits
+// for the call or for ctx to be done. The call is shared by all the callers waiting for it,
+// so fn must not use the context of any of them.
+func (g *flightGroup[V]) do(ctx context.Context, key string, fn func() (V, error)) (V, error) {
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if !ok {
+		if g.calls == nil {
+			g.calls = make(map[string]*flightCall[V])
+		}
+		call = &flightCall[V]{done: make(chan struct{})}
+		g.calls[key] = call
+
+		go func() {
+			call.value, call.err = fn()
+
+			g.mu.Lock()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+
+			close(call.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// forget forgets the calls in flight, so that later calls do not wait for them.
+func (g *flightGroup[V]) forget() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.calls = nil
+}
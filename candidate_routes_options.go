@@ -0,0 +1,56 @@
+package sqsclient
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// CandidateRoutesOptions is the type for the options for the /router/routes
+// and /router/cached-routes endpoints.
+type CandidateRoutesOptions struct {
+	// TokenInDenom is the denom to swap from.
+	TokenInDenom string
+
+	// TokenOutDenom is the denom to swap to.
+	TokenOutDenom string
+
+	// HumanDenoms is whether the denoms are human readable denoms.
+	HumanDenoms bool
+}
+
+// CandidateRoutesOption is the type for the options for the /router/routes
+// and /router/cached-routes endpoints.
+type CandidateRoutesOption func(opts *CandidateRoutesOptions)
+
+// WithHumanDenomsRoutes is an option to set the human denoms for the /router/routes
+// and /router/cached-routes endpoints.
+func WithHumanDenomsRoutes() CandidateRoutesOption {
+	return func(opts *CandidateRoutesOptions) {
+		opts.HumanDenoms = true
+	}
+}
+
+// Validate validates the options for the /router/routes and /router/cached-routes endpoints.
+func (opts *CandidateRoutesOptions) Validate() error {
+	if opts.TokenInDenom == "" || opts.TokenOutDenom == "" {
+		return fmt.Errorf("token in denom and token out denom are required")
+	}
+
+	if opts.TokenInDenom == opts.TokenOutDenom {
+		return fmt.Errorf("token in denom and token out denom must be different")
+	}
+
+	return nil
+}
+
+// CreateQueryParams creates the query params for the /router/routes and /router/cached-routes endpoints.
+func (opts *CandidateRoutesOptions) CreateQueryParams() url.Values {
+	queryParams := url.Values{}
+	queryParams.Add("tokenIn", opts.TokenInDenom)
+	queryParams.Add("tokenOutDenom", opts.TokenOutDenom)
+	queryParams.Add("humanDenoms", strconv.FormatBool(opts.HumanDenoms))
+	return queryParams
+}
+
+var _ Options = &CandidateRoutesOptions{}
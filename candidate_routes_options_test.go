@@ -0,0 +1,84 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+const candidateRoutesResponse = `{
+	"Routes": [
+		{"Pools": [{"ID": 1, "TokenOutDenom": "uion"}], "IsCanonicalOrderboolRoute": false},
+		{"Pools": [{"ID": 1135, "TokenOutDenom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}, {"ID": 2, "TokenOutDenom": "uion"}], "IsCanonicalOrderboolRoute": false}
+	],
+	"UniquePoolIDs": {"1": {}, "2": {}, "1135": {}},
+	"ContainsCanonicalOrderbook": false
+}`
+
+func TestGetCandidateRoutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		get      func(sqs sqsclient.SQSClient) (sqsclient.CandidateRoutes, error)
+
+		expectedCached bool
+	}{
+		{
+			name:     "candidate routes",
+			endpoint: "/router/routes",
+			get: func(sqs sqsclient.SQSClient) (sqsclient.CandidateRoutes, error) {
+				return sqs.GetCandidateRoutes(context.Background(), uosmoDenom, uionDenom)
+			},
+		},
+		{
+			name:     "cached routes",
+			endpoint: "/router/cached-routes",
+			get: func(sqs sqsclient.SQSClient) (sqsclient.CandidateRoutes, error) {
+				return sqs.GetCachedRoutes(context.Background(), uosmoDenom, uionDenom)
+			},
+
+			expectedCached: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, tc.endpoint, r.URL.Path)
+				require.Equal(t, uosmoDenom, r.URL.Query().Get("tokenIn"))
+				require.Equal(t, uionDenom, r.URL.Query().Get("tokenOutDenom"))
+				_, _ = w.Write([]byte(candidateRoutesResponse))
+			}))
+			defer server.Close()
+
+			sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+			require.NoError(t, err)
+
+			routes, err := tc.get(sqs)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCached, routes.Cached)
+			require.Len(t, routes.Routes, 2)
+			require.Equal(t, []sqsclient.CandidatePool{
+				{ID: 1135, TokenOutDenom: atomDenom},
+				{ID: 2, TokenOutDenom: uionDenom},
+			}, routes.Routes[1].Pools)
+			require.Equal(t, []uint64{1, 1135, 2}, routes.PoolIDs())
+		})
+	}
+}
+
+func TestCandidateRoutesOptions_Validate(t *testing.T) {
+	sqs, err := sqsclient.Initialize()
+	require.NoError(t, err)
+
+	_, err = sqs.GetCandidateRoutes(context.Background(), uosmoDenom, "")
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+
+	_, err = sqs.GetCachedRoutes(context.Background(), uosmoDenom, uosmoDenom)
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
@@ -0,0 +1,319 @@
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling SQS when the circuit breaker
+// of the endpoint family is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through while counting failures.
+	CircuitClosed CircuitState = iota
+	// CircuitHalfOpen lets a limited number of probe requests through after the cool-down.
+	CircuitHalfOpen
+	// CircuitOpen fails all requests fast with ErrCircuitOpen.
+	CircuitOpen
+)
+
+// String implements fmt.Stringer.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitHalfOpen:
+		return "half-open"
+	case CircuitOpen:
+		return "open"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// CircuitBreakerConfig configures the circuit breakers of the SQS client.
+// Each endpoint family, e.g. "router" or "tokens", has its own circuit breaker.
+type CircuitBreakerConfig struct {
+	// FailureRatio is the ratio of failed requests, in (0, 1], that opens the circuit.
+	FailureRatio float64
+	// MinRequests is the number of requests in the window before FailureRatio is evaluated.
+	MinRequests int
+	// Interval is the window over which requests are counted in the closed state.
+	// If zero, counts are only reset on state changes.
+	Interval time.Duration
+	// CoolDown is how long the circuit stays open before letting probe requests through.
+	CoolDown time.Duration
+	// HalfOpenMaxRequests is the number of probe requests let through in the half-open state.
+	// The circuit closes once they all succeed and opens again on the first failure.
+	HalfOpenMaxRequests int
+	// OnStateChange, if set, is called on every state transition.
+	OnStateChange func(family string, from, to CircuitState)
+	// IsFailure, if set, decides whether an error counts as a failure.
+	// By default, network errors, timeouts and 5xx responses are failures.
+	IsFailure func(err error) bool
+}
+
+// DefaultCircuitBreakerConfig returns a circuit breaker configuration suitable for most callers.
+// The circuit opens when half of at least 10 requests within a minute fail,
+// and probes again after 30 seconds.
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		FailureRatio:        0.5,
+		MinRequests:         10,
+		Interval:            time.Minute,
+		CoolDown:            30 * time.Second,
+		HalfOpenMaxRequests: 1,
+	}
+}
+
+// Validate validates the CircuitBreakerConfig.
+func (c *CircuitBreakerConfig) Validate() error {
+	if c.FailureRatio <= 0 || c.FailureRatio > 1 {
+		return errors.New("circuit breaker failure ratio must be in (0, 1]")
+	}
+
+	if c.MinRequests < 1 {
+		return errors.New("circuit breaker min requests must be positive")
+	}
+
+	if c.Interval < 0 {
+		return errors.New("circuit breaker interval cannot be negative")
+	}
+
+	if c.CoolDown <= 0 {
+		return errors.New("circuit breaker cool-down must be positive")
+	}
+
+	if c.HalfOpenMaxRequests < 1 {
+		return errors.New("circuit breaker half-open max requests must be positive")
+	}
+
+	return nil
+}
+
+// isFailure returns true if the error counts as a failure for the circuit breaker.
+func (c *CircuitBreakerConfig) isFailure(err error) bool {
+	if c.IsFailure != nil {
+		return c.IsFailure(err)
+	}
+
+	var requestErr *RequestError
+	return errors.As(err, &requestErr) || errors.Is(err, ErrServerError)
+}
+
+// WithCircuitBreaker is a helper function to enable the circuit breakers of the sqs client.
+func WithCircuitBreaker(config CircuitBreakerConfig, sqs *sqs) *sqs {
+	sqs.circuitBreakers = newCircuitBreakers(config)
+	return sqs
+}
+
+// endpointFamily returns the family of the endpoint, which is its first path segment.
+// E.g. "router/quote" and "router/custom-direct-quote" both belong to "router".
+func endpointFamily(endpoint string) string {
+	family, _, _ := strings.Cut(endpoint, "/")
+	return family
+}
+
+// circuitBreakers holds a circuit breaker per endpoint family.
+type circuitBreakers struct {
+	mu sync.Mutex
+
+	config   CircuitBreakerConfig
+	breakers map[string]*circuitBreaker
+}
+
+func newCircuitBreakers(config CircuitBreakerConfig) *circuitBreakers {
+	return &circuitBreakers{
+		config:   config,
+		breakers: make(map[string]*circuitBreaker),
+	}
+}
+
+// execute runs the operation through the circuit breaker of the endpoint family.
+// It fails fast with ErrCircuitOpen if the circuit does not allow the request.
+func (c *circuitBreakers) execute(ctx context.Context, endpoint string, operation func() (httpResponse, error)) (httpResponse, error) {
+	if c == nil {
+		return operation()
+	}
+
+	breaker := c.get(endpointFamily(endpoint))
+
+	generation, err := breaker.allow()
+	if err != nil {
+		return httpResponse{}, fmt.Errorf("sqs %s: %w", endpoint, err)
+	}
+
+	response, err := operation()
+
+	switch {
+	case err == nil:
+		breaker.record(generation, outcomeSuccess)
+	case ctx.Err() != nil:
+		// The caller gave up, which says nothing about the health of SQS.
+		breaker.record(generation, outcomeIgnored)
+	case c.config.isFailure(err):
+		breaker.record(generation, outcomeFailure)
+	default:
+		breaker.record(generation, outcomeSuccess)
+	}
+
+	return response, err
+}
+
+// get returns the circuit breaker of the given endpoint family, creating it if needed.
+func (c *circuitBreakers) get(family string) *circuitBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	breaker, ok := c.breakers[family]
+	if !ok {
+		breaker = &circuitBreaker{
+			family: family,
+			config: &c.config,
+		}
+		breaker.resetCounts(time.Now())
+		c.breakers[family] = breaker
+	}
+
+	return breaker
+}
+
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota
+	outcomeFailure
+	outcomeIgnored
+)
+
+// circuitBreaker is the circuit breaker of a single endpoint family.
+// Results of requests let through in a previous state are ignored,
+// which is tracked with the generation counter.
+type circuitBreaker struct {
+	mu sync.Mutex
+
+	family string
+	config *CircuitBreakerConfig
+
+	state      CircuitState
+	generation uint64
+	requests   int
+	failures   int
+	successes  int
+	expiry     time.Time
+
+	transitions [][2]CircuitState
+}
+
+// allow returns the current generation if a request is allowed, or ErrCircuitOpen.
+func (cb *circuitBreaker) allow() (uint64, error) {
+	cb.mu.Lock()
+	defer cb.unlockAndNotify()
+
+	cb.refresh(time.Now())
+
+	if cb.state == CircuitOpen || (cb.state == CircuitHalfOpen && cb.requests >= cb.config.HalfOpenMaxRequests) {
+		return 0, ErrCircuitOpen
+	}
+
+	cb.requests++
+	return cb.generation, nil
+}
+
+// record records the outcome of a request let through in the given generation.
+func (cb *circuitBreaker) record(generation uint64, result outcome) {
+	cb.mu.Lock()
+	defer cb.unlockAndNotify()
+
+	now := time.Now()
+	cb.refresh(now)
+
+	if generation != cb.generation {
+		return
+	}
+
+	switch result {
+	case outcomeIgnored:
+		cb.requests--
+	case outcomeFailure:
+		cb.failures++
+		if cb.state == CircuitHalfOpen {
+			cb.setState(CircuitOpen, now)
+		} else if cb.requests >= cb.config.MinRequests && float64(cb.failures)/float64(cb.requests) >= cb.config.FailureRatio {
+			cb.setState(CircuitOpen, now)
+		}
+	case outcomeSuccess:
+		cb.successes++
+		if cb.state == CircuitHalfOpen && cb.successes >= cb.config.HalfOpenMaxRequests {
+			cb.setState(CircuitClosed, now)
+		}
+	}
+}
+
+// refresh moves an open circuit to half-open after the cool-down and resets
+// the counts of a closed circuit at the end of the interval.
+// The caller must hold the lock.
+func (cb *circuitBreaker) refresh(now time.Time) {
+	switch cb.state {
+	case CircuitOpen:
+		if !now.Before(cb.expiry) {
+			cb.setState(CircuitHalfOpen, now)
+		}
+	case CircuitClosed:
+		if !cb.expiry.IsZero() && !now.Before(cb.expiry) {
+			cb.resetCounts(now)
+		}
+	}
+}
+
+// setState transitions the circuit to the given state. The caller must hold the lock.
+func (cb *circuitBreaker) setState(state CircuitState, now time.Time) {
+	if cb.state == state {
+		return
+	}
+
+	cb.transitions = append(cb.transitions, [2]CircuitState{cb.state, state})
+	cb.state = state
+	cb.resetCounts(now)
+}
+
+// resetCounts starts a new generation. The caller must hold the lock.
+func (cb *circuitBreaker) resetCounts(now time.Time) {
+	cb.generation++
+	cb.requests = 0
+	cb.failures = 0
+	cb.successes = 0
+
+	switch {
+	case cb.state == CircuitOpen:
+		cb.expiry = now.Add(cb.config.CoolDown)
+	case cb.state == CircuitClosed && cb.config.Interval > 0:
+		cb.expiry = now.Add(cb.config.Interval)
+	default:
+		cb.expiry = time.Time{}
+	}
+}
+
+// unlockAndNotify releases the lock and then calls the state change hook
+// for the transitions that happened while it was held.
+func (cb *circuitBreaker) unlockAndNotify() {
+	transitions := cb.transitions
+	cb.transitions = nil
+	cb.mu.Unlock()
+
+	if cb.config.OnStateChange == nil {
+		return
+	}
+
+	for _, transition := range transitions {
+		cb.config.OnStateChange(cb.family, transition[0], transition[1])
+	}
+}
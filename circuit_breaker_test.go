@@ -0,0 +1,112 @@
+package sqsclient_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+// transitionRecorder records the transitions reported to OnStateChange.
+type transitionRecorder struct {
+	mu          sync.Mutex
+	transitions []string
+}
+
+func (r *transitionRecorder) record(family string, from, to sqsclient.CircuitState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transitions = append(r.transitions, fmt.Sprintf("%s:%s->%s", family, from, to))
+}
+
+func (r *transitionRecorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.transitions...)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	var (
+		calls      atomic.Int32
+		statusCode atomic.Int32
+	)
+	statusCode.Store(http.StatusServiceUnavailable)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(int(statusCode.Load()))
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	recorder := &transitionRecorder{}
+
+	config := sqsclient.DefaultCircuitBreakerConfig()
+	config.MinRequests = 2
+	config.CoolDown = 50 * time.Millisecond
+	config.OnStateChange = recorder.record
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithCircuitBreakerOpt(config))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// Two failures open the circuit.
+	for i := 0; i < 2; i++ {
+		_, err = sqs.GetTokensMetadata(ctx)
+		require.ErrorIs(t, err, sqsclient.ErrServerError)
+	}
+	require.Equal(t, []string{"tokens:closed->open"}, recorder.get())
+
+	// The open circuit fails fast without calling SQS.
+	_, err = sqs.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom))
+	require.ErrorIs(t, err, sqsclient.ErrCircuitOpen)
+	require.Equal(t, int32(2), calls.Load())
+
+	// Other endpoint families are not affected.
+	_, err = sqs.GetQuote(ctx, sqsclient.WithOutGivenIn(1000000, uosmoDenom, uionDenom))
+	require.ErrorIs(t, err, sqsclient.ErrServerError)
+	require.Equal(t, int32(3), calls.Load())
+
+	// After the cool-down, a successful probe closes the circuit.
+	statusCode.Store(http.StatusOK)
+	time.Sleep(config.CoolDown)
+
+	_, err = sqs.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tokens:closed->open", "tokens:open->half-open", "tokens:half-open->closed"}, recorder.get())
+}
+
+func TestCircuitBreaker_ClientErrorsAreNotFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	config := sqsclient.DefaultCircuitBreakerConfig()
+	config.MinRequests = 1
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithCircuitBreakerOpt(config))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = sqs.GetTokensMetadata(context.Background())
+		require.ErrorIs(t, err, sqsclient.ErrBadRequest)
+	}
+}
+
+func TestCircuitBreakerConfig_Validate(t *testing.T) {
+	config := sqsclient.DefaultCircuitBreakerConfig()
+	config.FailureRatio = 0
+
+	_, err := sqsclient.Initialize(sqsclient.WithCircuitBreakerOpt(config))
+	require.Error(t, err)
+}
@@ -3,3 +3,24 @@ package sqsclient
 const (
 	APIKeyHeader = "x-api-key-header"
 )
+
+// Endpoints of the SQS API. They are the keys of per-endpoint configuration,
+// such as the RateLimiter budgets, and are reported in errors.
+const (
+	RouterQuoteEndpoint             = "router/quote"
+	RouterCustomDirectQuoteEndpoint = "router/custom-direct-quote"
+	TokensPricesEndpoint            = "tokens/prices"
+	TokensMetadataEndpoint          = "tokens/metadata"
+	TokensPoolMetadataEndpoint      = "tokens/pool-metadata"
+	PoolsEndpoint                   = "pools"
+	RouterSpotPricePoolEndpoint     = "router/spot-price-pool/{id}"
+	RouterRoutesEndpoint            = "router/routes"
+	RouterCachedRoutesEndpoint      = "router/cached-routes"
+	PoolTicksEndpoint               = "pools/ticks/{id}"
+	ActiveOrdersEndpoint            = "pools/all-orders"
+	HistoricalOrdersEndpoint        = "pools/order-history"
+	PortfolioAssetsEndpoint         = "passthrough/portfolio-assets/{address}"
+	HealthCheckEndpoint             = "healthcheck"
+	VersionEndpoint                 = "version"
+	ConfigEndpoint                  = "config"
+)
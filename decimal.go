@@ -0,0 +1,366 @@
+package sqsclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DecPrecision is the number of decimal places of a Dec.
+const DecPrecision = 36
+
+// maxDecExponent is the maximum absolute exponent of a decimal string in exponent notation.
+const maxDecExponent = 1000
+
+// decPrecisionMultiplier is 10^DecPrecision.
+var decPrecisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(DecPrecision), nil)
+
+// Dec is an arbitrary-precision decimal number with DecPrecision decimal places,
+// as used by SQS for prices. The zero value is 0.
+type Dec struct {
+	// i is the decimal scaled by 10^DecPrecision.
+	i *big.Int
+}
+
+// ZeroDec returns 0.
+func ZeroDec() Dec {
+	return Dec{i: new(big.Int)}
+}
+
+// OneDec returns 1.
+func OneDec() Dec {
+	return Dec{i: new(big.Int).Set(decPrecisionMultiplier)}
+}
+
+// NewDec returns the decimal of the given integer.
+func NewDec(i int64) Dec {
+	return NewDecWithPrec(i, 0)
+}
+
+// NewDecWithPrec returns i * 10^-prec, e.g. NewDecWithPrec(15, 1) is 1.5.
+// It panics if prec is negative or greater than DecPrecision.
+func NewDecWithPrec(i int64, prec int64) Dec {
+	if prec < 0 || prec > DecPrecision {
+		panic(fmt.Sprintf("precision must be between 0 and %d, got %d", DecPrecision, prec))
+	}
+
+	return Dec{i: new(big.Int).Mul(big.NewInt(i), pow10(DecPrecision-int(prec)))}
+}
+
+// NewDecFromInt returns the decimal of the given integer.
+func NewDecFromInt(i Int) Dec {
+	return Dec{i: new(big.Int).Mul(i.bigInt(), decPrecisionMultiplier)}
+}
+
+// NewDecFromStr parses a decimal string, e.g. "-12.345", optionally in exponent
+// notation, e.g. "1.5e-7" as JSON numbers may be encoded.
+// It returns an error if the decimal has more than DecPrecision decimal places.
+func NewDecFromStr(str string) (Dec, error) {
+	original := str
+	if str == "" {
+		return Dec{}, fmt.Errorf("decimal string is empty")
+	}
+
+	negative := strings.HasPrefix(str, "-")
+	if negative {
+		str = str[1:]
+	}
+
+	exponent := 0
+	if i := strings.IndexAny(str, "eE"); i != -1 {
+		var err error
+		exponent, err = strconv.Atoi(str[i+1:])
+		if err != nil || exponent < -maxDecExponent || exponent > maxDecExponent {
+			return Dec{}, fmt.Errorf("invalid decimal string %q", original)
+		}
+		str = str[:i]
+	}
+
+	integerPart, fractionalPart, hasPoint := strings.Cut(str, ".")
+	if integerPart == "" || (hasPoint && fractionalPart == "") {
+		return Dec{}, fmt.Errorf("invalid decimal string %q", original)
+	}
+
+	digits := integerPart + fractionalPart
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Dec{}, fmt.Errorf("invalid decimal string %q", original)
+		}
+	}
+
+	i, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Dec{}, fmt.Errorf("invalid decimal string %q", original)
+	}
+
+	// Scale the digits to DecPrecision decimal places.
+	if shift := DecPrecision - len(fractionalPart) + exponent; shift >= 0 {
+		i.Mul(i, pow10(shift))
+	} else {
+		var remainder big.Int
+		if i.QuoRem(i, pow10(-shift), &remainder); remainder.Sign() != 0 {
+			return Dec{}, fmt.Errorf("decimal string %q has more than %d decimal places", original, DecPrecision)
+		}
+	}
+
+	if negative {
+		i.Neg(i)
+	}
+
+	return Dec{i: i}, nil
+}
+
+// MustNewDecFromStr is like NewDecFromStr but panics on error.
+func MustNewDecFromStr(str string) Dec {
+	d, err := NewDecFromStr(str)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// bigInt returns the scaled integer of the decimal, treating the zero value as 0.
+func (d Dec) bigInt() *big.Int {
+	if d.i == nil {
+		return new(big.Int)
+	}
+	return d.i
+}
+
+// IsZero returns true if the decimal is 0.
+func (d Dec) IsZero() bool {
+	return d.bigInt().Sign() == 0
+}
+
+// IsNegative returns true if the decimal is lower than 0.
+func (d Dec) IsNegative() bool {
+	return d.bigInt().Sign() < 0
+}
+
+// IsPositive returns true if the decimal is greater than 0.
+func (d Dec) IsPositive() bool {
+	return d.bigInt().Sign() > 0
+}
+
+// Cmp returns -1, 0 or 1 if d is lower than, equal to or greater than d2.
+func (d Dec) Cmp(d2 Dec) int {
+	return d.bigInt().Cmp(d2.bigInt())
+}
+
+// Equal returns true if d and d2 are equal.
+func (d Dec) Equal(d2 Dec) bool {
+	return d.Cmp(d2) == 0
+}
+
+// GT returns true if d is greater than d2.
+func (d Dec) GT(d2 Dec) bool {
+	return d.Cmp(d2) > 0
+}
+
+// GTE returns true if d is greater than or equal to d2.
+func (d Dec) GTE(d2 Dec) bool {
+	return d.Cmp(d2) >= 0
+}
+
+// LT returns true if d is lower than d2.
+func (d Dec) LT(d2 Dec) bool {
+	return d.Cmp(d2) < 0
+}
+
+// LTE returns true if d is lower than or equal to d2.
+func (d Dec) LTE(d2 Dec) bool {
+	return d.Cmp(d2) <= 0
+}
+
+// Neg returns -d.
+func (d Dec) Neg() Dec {
+	return Dec{i: new(big.Int).Neg(d.bigInt())}
+}
+
+// Abs returns the absolute value of d.
+func (d Dec) Abs() Dec {
+	return Dec{i: new(big.Int).Abs(d.bigInt())}
+}
+
+// Add returns d + d2.
+func (d Dec) Add(d2 Dec) Dec {
+	return Dec{i: new(big.Int).Add(d.bigInt(), d2.bigInt())}
+}
+
+// Sub returns d - d2.
+func (d Dec) Sub(d2 Dec) Dec {
+	return Dec{i: new(big.Int).Sub(d.bigInt(), d2.bigInt())}
+}
+
+// Mul returns d * d2, rounded half to even like the Cosmos SDK LegacyDec.
+func (d Dec) Mul(d2 Dec) Dec {
+	mul := new(big.Int).Mul(d.bigInt(), d2.bigInt())
+	return Dec{i: chopPrecisionAndRound(mul)}
+}
+
+// MulTruncate returns d * d2, truncated towards zero.
+func (d Dec) MulTruncate(d2 Dec) Dec {
+	mul := new(big.Int).Mul(d.bigInt(), d2.bigInt())
+	return Dec{i: mul.Quo(mul, decPrecisionMultiplier)}
+}
+
+// MulRoundUp returns d * d2, rounded towards positive infinity.
+func (d Dec) MulRoundUp(d2 Dec) Dec {
+	mul := new(big.Int).Mul(d.bigInt(), d2.bigInt())
+	return Dec{i: quoRoundUp(mul, decPrecisionMultiplier)}
+}
+
+// MulInt returns d * i.
+func (d Dec) MulInt(i Int) Dec {
+	return Dec{i: new(big.Int).Mul(d.bigInt(), i.bigInt())}
+}
+
+// Quo returns d / d2, rounded half to even like the Cosmos SDK LegacyDec.
+// It panics if d2 is zero.
+func (d Dec) Quo(d2 Dec) Dec {
+	// Scaled twice so that the quotient keeps one extra DecPrecision of digits to round.
+	mul := new(big.Int).Mul(d.bigInt(), decPrecisionMultiplier)
+	mul.Mul(mul, decPrecisionMultiplier)
+	return Dec{i: chopPrecisionAndRound(mul.Quo(mul, d2.bigInt()))}
+}
+
+// QuoTruncate returns d / d2, truncated towards zero.
+// It panics if d2 is zero.
+func (d Dec) QuoTruncate(d2 Dec) Dec {
+	mul := new(big.Int).Mul(d.bigInt(), decPrecisionMultiplier)
+	return Dec{i: mul.Quo(mul, d2.bigInt())}
+}
+
+// QuoRoundUp returns d / d2, rounded towards positive infinity.
+// It panics if d2 is zero.
+func (d Dec) QuoRoundUp(d2 Dec) Dec {
+	mul := new(big.Int).Mul(d.bigInt(), decPrecisionMultiplier)
+	return Dec{i: quoRoundUp(mul, d2.bigInt())}
+}
+
+// QuoInt returns d / i, truncated towards zero.
+// It panics if i is zero.
+func (d Dec) QuoInt(i Int) Dec {
+	return Dec{i: new(big.Int).Quo(d.bigInt(), i.bigInt())}
+}
+
+// TruncateInt returns the integer part of d, truncated towards zero.
+func (d Dec) TruncateInt() Int {
+	return Int{i: new(big.Int).Quo(d.bigInt(), decPrecisionMultiplier)}
+}
+
+// TruncateDec returns the integer part of d as a decimal, truncated towards zero.
+func (d Dec) TruncateDec() Dec {
+	return NewDecFromInt(d.TruncateInt())
+}
+
+// RoundInt returns d rounded half to even to an integer.
+func (d Dec) RoundInt() Int {
+	return Int{i: chopPrecisionAndRound(new(big.Int).Set(d.bigInt()))}
+}
+
+// Ceil returns the smallest integer greater than or equal to d, as a decimal.
+func (d Dec) Ceil() Dec {
+	return NewDecFromInt(Int{i: quoRoundUp(new(big.Int).Set(d.bigInt()), decPrecisionMultiplier)})
+}
+
+// String returns the decimal with DecPrecision decimal places, e.g. "1.500000000000000000000000000000000000".
+func (d Dec) String() string {
+	i := d.bigInt()
+
+	digits := new(big.Int).Abs(i).String()
+	if len(digits) <= DecPrecision {
+		digits = strings.Repeat("0", DecPrecision-len(digits)+1) + digits
+	}
+
+	point := len(digits) - DecPrecision
+	str := digits[:point] + "." + digits[point:]
+
+	if i.Sign() < 0 {
+		return "-" + str
+	}
+	return str
+}
+
+// MarshalJSON implements json.Marshaler. The decimal is encoded as a string.
+func (d Dec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts both strings and numbers,
+// including numbers in exponent notation.
+// Null and empty strings decode as 0.
+func (d *Dec) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == `""` {
+		*d = Dec{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		var number json.Number
+		if err := json.Unmarshal(data, &number); err != nil {
+			return fmt.Errorf("decimal must be a string or a number: %w", err)
+		}
+		str = number.String()
+	}
+
+	parsed, err := NewDecFromStr(str)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// chopPrecisionAndRound divides x by 10^DecPrecision, rounding half to even.
+// x is modified.
+func chopPrecisionAndRound(x *big.Int) *big.Int {
+	negative := x.Sign() < 0
+	if negative {
+		x.Neg(x)
+	}
+
+	quo, rem := new(big.Int).QuoRem(x, decPrecisionMultiplier, x)
+
+	// Compares 2*rem with 10^DecPrecision rather than rem with half of it.
+	switch rem.Lsh(rem, 1).Cmp(decPrecisionMultiplier) {
+	case 1:
+		quo.Add(quo, big.NewInt(1))
+	case 0:
+		if quo.Bit(0) == 1 {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	if negative {
+		quo.Neg(quo)
+	}
+	return quo
+}
+
+// quoRoundUp returns x / y rounded towards positive infinity. x is modified.
+func quoRoundUp(x *big.Int, y *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(x, y, x)
+	if rem.Sign() != 0 && (rem.Sign() > 0) == (y.Sign() > 0) {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return quo
+}
+
+// pow10 returns 10^n for n >= 0.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// mulPow10 returns d * 10^n. Digits beyond DecPrecision are truncated when n is negative.
+func (d Dec) mulPow10(n int) Dec {
+	i := new(big.Int).Set(d.bigInt())
+	if n >= 0 {
+		return Dec{i: i.Mul(i, pow10(n))}
+	}
+	return Dec{i: i.Quo(i, pow10(-n))}
+}
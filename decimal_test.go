@@ -0,0 +1,141 @@
+package sqsclient_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestNewDecFromStr(t *testing.T) {
+	tests := []struct {
+		input string
+
+		expected    string
+		expectedErr bool
+	}{
+		{input: "0", expected: "0.000000000000000000000000000000000000"},
+		{input: "1.5", expected: "1.500000000000000000000000000000000000"},
+		{input: "-12.345", expected: "-12.345000000000000000000000000000000000"},
+		{input: "0.000000000000000000000000000000000001", expected: "0.000000000000000000000000000000000001"},
+		{input: "123456789012345678901234567890", expected: "123456789012345678901234567890.000000000000000000000000000000000000"},
+		{input: "0.0000000000000000000000000000000000001", expectedErr: true},
+		{input: "", expectedErr: true},
+		{input: ".5", expectedErr: true},
+		{input: "1.", expectedErr: true},
+		{input: "1.2.3", expectedErr: true},
+		{input: "abc", expectedErr: true},
+		{input: "--1", expectedErr: true},
+		{input: "1e-7", expected: "0.000000100000000000000000000000000000"},
+		{input: "-2.5E+2", expected: "-250.000000000000000000000000000000000000"},
+		{input: "1.25e1", expected: "12.500000000000000000000000000000000000"},
+		{input: "1e-36", expected: "0.000000000000000000000000000000000001"},
+		{input: "1e-37", expectedErr: true},
+		{input: "1e", expectedErr: true},
+		{input: "e5", expectedErr: true},
+		{input: "1e1.5", expectedErr: true},
+		{input: "1e100000", expectedErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			d, err := sqsclient.NewDecFromStr(tc.input)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, d.String())
+		})
+	}
+}
+
+func TestDec_JSON(t *testing.T) {
+	var d sqsclient.Dec
+	require.NoError(t, json.Unmarshal([]byte(`"0.25"`), &d))
+	require.Equal(t, sqsclient.MustNewDecFromStr("0.25"), d)
+
+	require.NoError(t, json.Unmarshal([]byte(`3.5`), &d))
+	require.Equal(t, sqsclient.MustNewDecFromStr("3.5"), d)
+
+	bz, err := json.Marshal(d)
+	require.NoError(t, err)
+	require.Equal(t, `"3.500000000000000000000000000000000000"`, string(bz))
+
+	require.True(t, sqsclient.Dec{}.IsZero())
+	require.Equal(t, "0.000000000000000000000000000000000000", sqsclient.Dec{}.String())
+
+	// JSON numbers may be in exponent notation.
+	require.NoError(t, json.Unmarshal([]byte(`1e-7`), &d))
+	require.Equal(t, sqsclient.MustNewDecFromStr("0.0000001"), d)
+}
+
+func TestDec_Arithmetic(t *testing.T) {
+	d := sqsclient.MustNewDecFromStr
+
+	require.True(t, d("1.5").Add(d("2.25")).Equal(d("3.75")))
+	require.True(t, d("1.5").Sub(d("2.25")).Equal(d("-0.75")))
+	require.True(t, d("1.5").Mul(d("-2")).Equal(d("-3")))
+	require.True(t, d("3").Quo(d("4")).Equal(d("0.75")))
+	require.True(t, d("-1.5").Abs().Equal(d("1.5")))
+	require.True(t, d("1.5").Neg().Equal(d("-1.5")))
+	require.True(t, d("1.5").MulInt(sqsclient.NewInt(3)).Equal(d("4.5")))
+	require.True(t, d("4.5").QuoInt(sqsclient.NewInt(3)).Equal(d("1.5")))
+
+	require.True(t, sqsclient.NewDec(2).Equal(d("2")))
+	require.True(t, sqsclient.NewDecWithPrec(15, 1).Equal(d("1.5")))
+	require.True(t, sqsclient.OneDec().Equal(d("1")))
+	require.True(t, sqsclient.ZeroDec().Equal(sqsclient.Dec{}))
+
+	require.True(t, d("2").GT(d("1")))
+	require.True(t, d("1").GTE(d("1")))
+	require.True(t, d("-2").LT(d("1")))
+	require.True(t, d("1").LTE(d("1")))
+	require.True(t, d("-0.1").IsNegative())
+	require.True(t, d("0.1").IsPositive())
+}
+
+func TestDec_Rounding(t *testing.T) {
+	d := sqsclient.MustNewDecFromStr
+
+	// 1/3 = 0.333...3|33, 2/3 = 0.666...6|66
+	require.Equal(t, "0.333333333333333333333333333333333333", d("1").Quo(d("3")).String())
+	require.Equal(t, "0.666666666666666666666666666666666667", d("2").Quo(d("3")).String())
+	require.Equal(t, "0.666666666666666666666666666666666666", d("2").QuoTruncate(d("3")).String())
+	require.Equal(t, "0.333333333333333333333333333333333334", d("1").QuoRoundUp(d("3")).String())
+	require.Equal(t, "-0.333333333333333333333333333333333333", d("-1").QuoRoundUp(d("3")).String())
+
+	// Half to even on the last digit.
+	tiny := d("0.000000000000000000000000000000000001")
+	half := d("0.5")
+	require.True(t, tiny.Mul(half).IsZero())
+	require.True(t, tiny.Mul(d("1.5")).Equal(d("0.000000000000000000000000000000000002")))
+	require.True(t, tiny.Mul(d("-1.5")).Equal(d("-0.000000000000000000000000000000000002")))
+	require.True(t, tiny.MulTruncate(d("1.5")).Equal(tiny))
+	require.True(t, tiny.MulRoundUp(half).Equal(tiny))
+
+	require.Equal(t, "2", d("2.5").RoundInt().String())
+	require.Equal(t, "4", d("3.5").RoundInt().String())
+	require.Equal(t, "-4", d("-3.5").RoundInt().String())
+	require.Equal(t, "3", d("3.51").TruncateInt().String())
+	require.Equal(t, "-3", d("-3.51").TruncateInt().String())
+	require.True(t, d("3.51").TruncateDec().Equal(d("3")))
+	require.True(t, d("3.01").Ceil().Equal(d("4")))
+	require.True(t, d("-3.99").Ceil().Equal(d("-3")))
+	require.True(t, d("3").Ceil().Equal(d("3")))
+
+	require.Panics(t, func() { d("1").Quo(sqsclient.ZeroDec()) })
+}
+
+func TestDec_NullJSON(t *testing.T) {
+	d := sqsclient.MustNewDecFromStr("1")
+	require.NoError(t, json.Unmarshal([]byte(`null`), &d))
+	require.True(t, d.IsZero())
+
+	d = sqsclient.MustNewDecFromStr("1")
+	require.NoError(t, json.Unmarshal([]byte(`""`), &d))
+	require.True(t, d.IsZero())
+}
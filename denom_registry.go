@@ -0,0 +1,187 @@
+package sqsclient
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// DenomRegistry resolves symbols to denoms and converts amounts between human units,
+// e.g. 1.5 OSMO, and base units, e.g. 1500000uosmo, using the token metadata from GetTokensMetadata.
+// It is safe for concurrent use.
+type DenomRegistry struct {
+	// tokens is the token metadata by chain denom.
+	tokens map[string]OsmosisTokenMetadata
+	// symbols is the chain denom by lower case symbol. An empty denom means the symbol is ambiguous.
+	symbols map[string]string
+}
+
+// NewDenomRegistry creates a DenomRegistry from the token metadata returned by GetTokensMetadata.
+// If several tokens have the same symbol, the one that is not in preview is used; if that does
+// not settle it, the symbol cannot be resolved and the tokens must be referred to by denom.
+func NewDenomRegistry(tokensMetadata map[string]OsmosisTokenMetadata) *DenomRegistry {
+	registry := &DenomRegistry{
+		tokens:  make(map[string]OsmosisTokenMetadata, len(tokensMetadata)),
+		symbols: make(map[string]string, len(tokensMetadata)),
+	}
+
+	for denom, metadata := range tokensMetadata {
+		if metadata.CoinMinimalDenom != "" {
+			denom = metadata.CoinMinimalDenom
+		}
+		registry.tokens[denom] = metadata
+	}
+
+	for denom, metadata := range registry.tokens {
+		if metadata.Symbol == "" {
+			continue
+		}
+
+		symbol := strings.ToLower(metadata.Symbol)
+		other, ok := registry.symbols[symbol]
+		switch {
+		case !ok:
+			registry.symbols[symbol] = denom
+		case other == "":
+			// Already ambiguous.
+		case registry.tokens[other].Preview && !metadata.Preview:
+			registry.symbols[symbol] = denom
+		case !registry.tokens[other].Preview && metadata.Preview:
+			// Keep the token that is not in preview.
+		default:
+			registry.symbols[symbol] = ""
+		}
+	}
+
+	return registry
+}
+
+// LoadDenomRegistry creates a DenomRegistry from the token metadata of the client.
+func LoadDenomRegistry(ctx context.Context, client SQSClient) (*DenomRegistry, error) {
+	tokensMetadata, err := client.GetTokensMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDenomRegistry(tokensMetadata), nil
+}
+
+// Resolve returns the chain denom of the given chain denom or symbol. Symbols are case-insensitive.
+// It returns an error wrapping ErrUnknownDenom if there is no token with that denom or symbol.
+func (r *DenomRegistry) Resolve(denomOrSymbol string) (string, error) {
+	if _, ok := r.tokens[denomOrSymbol]; ok {
+		return denomOrSymbol, nil
+	}
+
+	denom, ok := r.symbols[strings.ToLower(denomOrSymbol)]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownDenom, denomOrSymbol)
+	}
+
+	if denom == "" {
+		return "", fmt.Errorf("symbol %s is ambiguous, use the denom instead", denomOrSymbol)
+	}
+
+	return denom, nil
+}
+
+// Metadata returns the token metadata of the given chain denom or symbol.
+func (r *DenomRegistry) Metadata(denomOrSymbol string) (OsmosisTokenMetadata, error) {
+	denom, err := r.Resolve(denomOrSymbol)
+	if err != nil {
+		return OsmosisTokenMetadata{}, err
+	}
+
+	return r.tokens[denom], nil
+}
+
+// ToBaseUnits converts a human amount, e.g. "1.5", of the given denom or symbol to base units,
+// e.g. 1500000 for OSMO. It returns an error if the amount is negative or has more decimal
+// places than the token.
+func (r *DenomRegistry) ToBaseUnits(amount string, denomOrSymbol string) (Coin, error) {
+	denom, err := r.Resolve(denomOrSymbol)
+	if err != nil {
+		return Coin{}, err
+	}
+
+	humanAmount, err := NewDecFromStr(amount)
+	if err != nil {
+		return Coin{}, err
+	}
+
+	if humanAmount.IsNegative() {
+		return Coin{}, fmt.Errorf("amount %s cannot be negative", amount)
+	}
+
+	decimals := r.tokens[denom].Decimals
+	baseAmount := humanAmount.mulPow10(decimals)
+	if new(big.Int).Rem(baseAmount.bigInt(), decPrecisionMultiplier).Sign() != 0 {
+		return Coin{}, fmt.Errorf("amount %s of %s has more than %d decimal places", amount, denomOrSymbol, decimals)
+	}
+
+	return Coin{Denom: denom, Amount: baseAmount.TruncateInt().String()}, nil
+}
+
+// ToHuman converts an amount in base units of the given denom or symbol to a human amount,
+// e.g. 1500000 uosmo to 1.5.
+func (r *DenomRegistry) ToHuman(amount Int, denomOrSymbol string) (Dec, error) {
+	denom, err := r.Resolve(denomOrSymbol)
+	if err != nil {
+		return Dec{}, err
+	}
+
+	return amount.ToDec().mulPow10(-r.tokens[denom].Decimals), nil
+}
+
+// CoinToHuman converts a coin, e.g. the amount out of a quote, to a human amount.
+func (r *DenomRegistry) CoinToHuman(coin Coin) (Dec, error) {
+	amount, err := NewIntFromStr(coin.Amount)
+	if err != nil {
+		return Dec{}, fmt.Errorf("invalid amount of %s: %w", coin.Denom, err)
+	}
+
+	return r.ToHuman(amount, coin.Denom)
+}
+
+// WithOutGivenInHuman is like WithOutGivenIn, with the amount in human units and the denoms
+// given as chain denoms or symbols, e.g. WithOutGivenInHuman("1.5", "OSMO", "ATOM").
+// Conversion errors are returned by GetQuote.
+func (r *DenomRegistry) WithOutGivenInHuman(inAmount string, tokenIn string, tokenOut string) RouterQuoteOption {
+	return func(opts *RouterQuoteOptions) {
+		coinIn, err := r.ToBaseUnits(inAmount, tokenIn)
+		if err != nil {
+			opts.err = err
+			return
+		}
+
+		tokenOutDenom, err := r.Resolve(tokenOut)
+		if err != nil {
+			opts.err = err
+			return
+		}
+
+		WithOutGivenIn(coinIn.Amount, coinIn.Denom, tokenOutDenom)(opts)
+	}
+}
+
+// WithInGivenOutHuman is like WithInGivenOut, with the amount in human units and the denoms
+// given as chain denoms or symbols, e.g. WithInGivenOutHuman("1.5", "ATOM", "OSMO").
+// Conversion errors are returned by GetQuote.
+func (r *DenomRegistry) WithInGivenOutHuman(outAmount string, tokenOut string, tokenIn string) RouterQuoteOption {
+	return func(opts *RouterQuoteOptions) {
+		coinOut, err := r.ToBaseUnits(outAmount, tokenOut)
+		if err != nil {
+			opts.err = err
+			return
+		}
+
+		tokenInDenom, err := r.Resolve(tokenIn)
+		if err != nil {
+			opts.err = err
+			return
+		}
+
+		WithInGivenOut(coinOut.Amount, coinOut.Denom, tokenInDenom)(opts)
+	}
+}
@@ -0,0 +1,140 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+	"github.com/osmosis-labs/sqs-go-client/sqsmock"
+)
+
+func newTestDenomRegistry() *sqsclient.DenomRegistry {
+	return sqsclient.NewDenomRegistry(map[string]sqsclient.OsmosisTokenMetadata{
+		uosmoDenom:   {Symbol: "OSMO", CoinMinimalDenom: uosmoDenom, Decimals: 6},
+		atomDenom:    {Symbol: "ATOM", CoinMinimalDenom: atomDenom, Decimals: 6},
+		"weth-wei":   {Symbol: "ETH", CoinMinimalDenom: "weth-wei", Decimals: 18},
+		"ibc/WETH":   {Symbol: "ETH", CoinMinimalDenom: "ibc/WETH", Decimals: 18, Preview: true},
+		"factory/a":  {Symbol: "DUP", CoinMinimalDenom: "factory/a", Decimals: 6},
+		"factory/b":  {Symbol: "DUP", CoinMinimalDenom: "factory/b", Decimals: 6},
+		"no-minimal": {Symbol: "NM", Decimals: 0},
+	})
+}
+
+func TestDenomRegistry_Resolve(t *testing.T) {
+	registry := newTestDenomRegistry()
+
+	tests := []struct {
+		input    string
+		expected string
+		wantErr  error
+	}{
+		{input: "OSMO", expected: uosmoDenom},
+		{input: "osmo", expected: uosmoDenom},
+		{input: uosmoDenom, expected: uosmoDenom},
+		{input: atomDenom, expected: atomDenom},
+		{input: "ETH", expected: "weth-wei"},
+		{input: "ibc/WETH", expected: "ibc/WETH"},
+		{input: "NM", expected: "no-minimal"},
+		{input: "DUP"},
+		{input: "BTC", wantErr: sqsclient.ErrUnknownDenom},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			denom, err := registry.Resolve(tc.input)
+			if tc.expected == "" {
+				require.Error(t, err)
+				if tc.wantErr != nil {
+					require.ErrorIs(t, err, tc.wantErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, denom)
+		})
+	}
+}
+
+func TestDenomRegistry_Conversions(t *testing.T) {
+	registry := newTestDenomRegistry()
+
+	coin, err := registry.ToBaseUnits("1.5", "OSMO")
+	require.NoError(t, err)
+	require.Equal(t, sqsclient.Coin{Denom: uosmoDenom, Amount: "1500000"}, coin)
+
+	coin, err = registry.ToBaseUnits("0.000000000000000001", "ETH")
+	require.NoError(t, err)
+	require.Equal(t, "1", coin.Amount)
+
+	_, err = registry.ToBaseUnits("1.0000001", "OSMO")
+	require.Error(t, err)
+
+	_, err = registry.ToBaseUnits("-1", "OSMO")
+	require.Error(t, err)
+
+	human, err := registry.ToHuman(sqsclient.NewInt(1500000), uosmoDenom)
+	require.NoError(t, err)
+	require.True(t, human.Equal(sqsclient.MustNewDecFromStr("1.5")))
+
+	human, err = registry.CoinToHuman(sqsclient.Coin{Denom: "weth-wei", Amount: "2500000000000000000"})
+	require.NoError(t, err)
+	require.True(t, human.Equal(sqsclient.MustNewDecFromStr("2.5")))
+
+	metadata, err := registry.Metadata("atom")
+	require.NoError(t, err)
+	require.Equal(t, "ATOM", metadata.Symbol)
+}
+
+func TestDenomRegistry_QuoteOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Has("tokenIn") {
+			require.Equal(t, "1500000"+uosmoDenom, query.Get("tokenIn"))
+			require.Equal(t, atomDenom, query.Get("tokenOutDenom"))
+			_, _ = w.Write([]byte(`{"amount_in":{"denom":"uosmo","amount":"1500000"},"amount_out":"42"}`))
+			return
+		}
+
+		require.Equal(t, "2000000"+atomDenom, query.Get("tokenOut"))
+		require.Equal(t, uosmoDenom, query.Get("tokenInDenom"))
+		_, _ = w.Write([]byte(`{"amount_in":"42","amount_out":{"denom":"` + atomDenom + `","amount":"2000000"}}`))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	registry := newTestDenomRegistry()
+	ctx := context.Background()
+
+	_, err = sqs.GetQuote(ctx, registry.WithOutGivenInHuman("1.5", "OSMO", "ATOM"))
+	require.NoError(t, err)
+
+	_, err = sqs.GetQuote(ctx, registry.WithInGivenOutHuman("2", "ATOM", "OSMO"))
+	require.NoError(t, err)
+
+	_, err = sqs.GetQuote(ctx, registry.WithOutGivenInHuman("1.5", "OSMO", "BTC"))
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+	require.ErrorIs(t, err, sqsclient.ErrUnknownDenom)
+}
+
+func TestLoadDenomRegistry(t *testing.T) {
+	mock := &sqsmock.SQSMock{
+		GetTokensMetadataFunc: func(ctx context.Context, options ...sqsclient.TokensMetadataOption) (map[string]sqsclient.OsmosisTokenMetadata, error) {
+			return map[string]sqsclient.OsmosisTokenMetadata{
+				uosmoDenom: {Symbol: "OSMO", CoinMinimalDenom: uosmoDenom, Decimals: 6},
+			}, nil
+		},
+	}
+
+	registry, err := sqsclient.LoadDenomRegistry(context.Background(), mock)
+	require.NoError(t, err)
+
+	denom, err := registry.Resolve("OSMO")
+	require.NoError(t, err)
+	require.Equal(t, uosmoDenom, denom)
+}
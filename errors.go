@@ -0,0 +1,144 @@
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors that can be matched with errors.Is against any error
+// returned by the SQS client.
+var (
+	// ErrInvalidOptions is returned when the request options fail validation.
+	ErrInvalidOptions = errors.New("invalid options")
+	// ErrBadRequest is returned when SQS responds with 400 Bad Request.
+	ErrBadRequest = errors.New("bad request")
+	// ErrUnauthorized is returned when SQS responds with 401 or 403,
+	// typically because of a missing or invalid API key.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrNotFound is returned when SQS responds with 404 Not Found.
+	ErrNotFound = errors.New("not found")
+	// ErrNoRoute is returned when the router could not find a route for the quote.
+	ErrNoRoute = errors.New("no route found")
+	// ErrRateLimited is returned when SQS responds with 429 Too Many Requests.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrServerError is returned when SQS responds with a 5xx status code.
+	ErrServerError = errors.New("server error")
+	// ErrTimeout is returned when the request times out before a response is received.
+	ErrTimeout = errors.New("request timed out")
+	// ErrDecode is returned when the response body cannot be decoded.
+	ErrDecode = errors.New("failed to decode response")
+	// ErrPriceNotFound is returned by Prices when there is no price for a base and quote denom.
+	ErrPriceNotFound = errors.New("price not found")
+	// ErrUnknownDenom is returned by DenomRegistry when a denom or symbol has no token metadata.
+	ErrUnknownDenom = errors.New("unknown denom")
+)
+
+// APIError is returned when SQS responds with a non-200 status code.
+type APIError struct {
+	// StatusCode is the HTTP status code returned by SQS.
+	StatusCode int
+	// Message is the error message returned by SQS.
+	// If the body is not a JSON error message, it is the raw body.
+	Message string
+	// Endpoint is the SQS endpoint that was called, e.g. "router/quote".
+	Endpoint string
+	// URL is the full request URL.
+	URL string
+	// RetryAfter is the wait requested by the Retry-After response header, if any.
+	RetryAfter time.Duration
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("sqs %s returned status %d: %s", e.Endpoint, e.StatusCode, e.Message)
+}
+
+// Is allows matching an APIError against the sentinel errors with errors.Is.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	case ErrTimeout:
+		return e.StatusCode == http.StatusRequestTimeout || e.StatusCode == http.StatusGatewayTimeout
+	case ErrNoRoute:
+		// SQS does not use a dedicated status code for missing routes,
+		// so the message is inspected instead.
+		return strings.Contains(strings.ToLower(e.Message), "no route")
+	}
+	return false
+}
+
+// RequestError is returned when the request could not be completed,
+// e.g. because of a network failure or a cancelled context.
+type RequestError struct {
+	// Endpoint is the SQS endpoint that was called, e.g. "router/quote".
+	Endpoint string
+	// URL is the full request URL.
+	URL string
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements error.
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("sqs %s request failed: %v", e.Endpoint, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows matching a RequestError against ErrTimeout with errors.Is.
+func (e *RequestError) Is(target error) bool {
+	if target != ErrTimeout {
+		return false
+	}
+
+	if errors.Is(e.Err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(e.Err, &netErr) && netErr.Timeout()
+}
+
+// DecodeError is returned when the response body could not be decoded.
+type DecodeError struct {
+	// Endpoint is the SQS endpoint that was called, e.g. "router/quote".
+	Endpoint string
+	// URL is the full request URL.
+	URL string
+	// Body is the raw response body.
+	Body []byte
+	// Err is the underlying decoding error.
+	Err error
+}
+
+// Error implements error.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("sqs %s: %v: %v", e.Endpoint, ErrDecode, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows matching a DecodeError against ErrDecode with errors.Is.
+func (e *DecodeError) Is(target error) bool {
+	return target == ErrDecode
+}
@@ -0,0 +1,138 @@
+package sqsclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+	"github.com/osmosis-labs/sqs-go-client/sqsmock"
+)
+
+func TestAPIErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+
+		expectedMessage string
+		expectedIs      []error
+		expectedIsNot   []error
+	}{
+		{
+			name:            "no route",
+			statusCode:      http.StatusBadRequest,
+			body:            `{"message":"no routes were found"}`,
+			expectedMessage: "no routes were found",
+			expectedIs:      []error{sqsclient.ErrNoRoute, sqsclient.ErrBadRequest},
+			expectedIsNot:   []error{sqsclient.ErrServerError, sqsclient.ErrRateLimited},
+		},
+		{
+			name:            "rate limited",
+			statusCode:      http.StatusTooManyRequests,
+			body:            "slow down",
+			expectedMessage: "slow down",
+			expectedIs:      []error{sqsclient.ErrRateLimited},
+			expectedIsNot:   []error{sqsclient.ErrNoRoute, sqsclient.ErrServerError},
+		},
+		{
+			name:            "unauthorized",
+			statusCode:      http.StatusForbidden,
+			body:            `{"message":"invalid api key"}`,
+			expectedMessage: "invalid api key",
+			expectedIs:      []error{sqsclient.ErrUnauthorized},
+			expectedIsNot:   []error{sqsclient.ErrNotFound},
+		},
+		{
+			name:            "server error",
+			statusCode:      http.StatusBadGateway,
+			body:            "",
+			expectedMessage: "",
+			expectedIs:      []error{sqsclient.ErrServerError},
+			expectedIsNot:   []error{sqsclient.ErrTimeout},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+			require.NoError(t, err)
+
+			_, err = sqs.GetQuote(context.Background(), sqsclient.WithOutGivenIn(1000000, uosmoDenom, uionDenom))
+			require.Error(t, err)
+
+			var apiErr *sqsclient.APIError
+			require.True(t, errors.As(err, &apiErr))
+			require.Equal(t, tc.statusCode, apiErr.StatusCode)
+			require.Equal(t, tc.expectedMessage, apiErr.Message)
+			require.Equal(t, "router/quote", apiErr.Endpoint)
+			require.Contains(t, apiErr.URL, server.URL+"/router/quote?")
+
+			for _, target := range tc.expectedIs {
+				require.ErrorIs(t, err, target)
+			}
+			for _, target := range tc.expectedIsNot {
+				require.NotErrorIs(t, err, target)
+			}
+		})
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not json"))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	_, err = sqs.GetTokensMetadata(context.Background())
+	require.ErrorIs(t, err, sqsclient.ErrDecode)
+
+	var decodeErr *sqsclient.DecodeError
+	require.True(t, errors.As(err, &decodeErr))
+	require.Equal(t, "tokens/metadata", decodeErr.Endpoint)
+	require.Equal(t, []byte("not json"), decodeErr.Body)
+}
+
+func TestRequestError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	_, err = sqs.GetPrices(context.Background(), sqsclient.WithBaseDenom(uosmoDenom))
+
+	var requestErr *sqsclient.RequestError
+	require.True(t, errors.As(err, &requestErr))
+	require.Equal(t, "tokens/prices", requestErr.Endpoint)
+}
+
+func TestInvalidOptionsError(t *testing.T) {
+	sqs, err := sqsclient.Initialize()
+	require.NoError(t, err)
+
+	_, err = sqs.GetPrices(context.Background())
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
+
+func TestMockAPIError(t *testing.T) {
+	mock := &sqsmock.SQSMock{
+		Err: sqsmock.NewAPIError("router/quote", http.StatusTooManyRequests, "rate limited"),
+	}
+
+	_, err := mock.GetQuote(context.Background())
+	require.ErrorIs(t, err, sqsclient.ErrRateLimited)
+}
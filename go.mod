@@ -2,10 +2,7 @@ module github.com/osmosis-labs/sqs-go-client
 
 go 1.22.5
 
-require (
-	github.com/osmosis-labs/osmoutil-go v0.0.16
-	github.com/stretchr/testify v1.10.0
-)
+require github.com/stretchr/testify v1.10.0
 
 require (
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
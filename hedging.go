@@ -0,0 +1,138 @@
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// HedgingPolicy configures hedged quote requests: if a quote request has not
+// answered within Delay, an identical request is sent and whichever completes
+// first is returned, cancelling the other.
+type HedgingPolicy struct {
+	// Delay is how long to wait for the first request before sending the hedge.
+	Delay time.Duration
+	// UseAlternateBackend is whether the hedge is sent to the next base URL
+	// instead of the same one. It has no effect without failover URLs.
+	UseAlternateBackend bool
+	// Stats, if set, is updated with how often requests were hedged and hedges won.
+	Stats *HedgingStats
+}
+
+// Validate validates the HedgingPolicy.
+func (p *HedgingPolicy) Validate() error {
+	if p.Delay <= 0 {
+		return errors.New("hedging delay must be positive")
+	}
+
+	return nil
+}
+
+// HedgingStats counts hedged requests. It is safe for concurrent use.
+type HedgingStats struct {
+	requests  atomic.Uint64
+	hedged    atomic.Uint64
+	hedgeWins atomic.Uint64
+}
+
+// Requests returns the number of requests eligible for hedging.
+func (s *HedgingStats) Requests() uint64 {
+	return s.requests.Load()
+}
+
+// Hedged returns the number of requests for which a hedge was sent.
+func (s *HedgingStats) Hedged() uint64 {
+	return s.hedged.Load()
+}
+
+// HedgeWins returns the number of requests answered by the hedge rather than the first request.
+func (s *HedgingStats) HedgeWins() uint64 {
+	return s.hedgeWins.Load()
+}
+
+// WithHedging is a helper function to enable hedged quote requests for the sqs client.
+func WithHedging(policy HedgingPolicy, sqs *sqs) *sqs {
+	sqs.hedgingPolicy = &policy
+	return sqs
+}
+
+// isHedgedEndpoint returns true if requests to the endpoint are hedged.
+func isHedgedEndpoint(endpoint string) bool {
+	return endpoint == RouterQuoteEndpoint || endpoint == RouterCustomDirectQuoteEndpoint
+}
+
+// hedgeResult is the result of one of the requests of a hedged request.
+type hedgeResult struct {
+	response httpResponse
+	err      error
+	hedge    bool
+}
+
+// doGetWithHedging calls doGetWithFailover, sending a hedge if the endpoint is hedged
+// and the first request has not answered within the hedging delay.
+// If the first request fails before the delay, its error is returned without hedging.
+func (o *sqs) doGetWithHedging(ctx context.Context, endpoint string, path string) (httpResponse, error) {
+	if o.hedgingPolicy == nil || !isHedgedEndpoint(endpoint) {
+		return o.doGetWithFailover(ctx, endpoint, path, 0)
+	}
+
+	policy := o.hedgingPolicy
+	if policy.Stats != nil {
+		policy.Stats.requests.Add(1)
+	}
+
+	// Cancels the losing request on return.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so that the losing request does not block once we have returned.
+	results := make(chan hedgeResult, 2)
+
+	go func() {
+		response, err := o.doGetWithFailover(ctx, endpoint, path, 0)
+		results <- hedgeResult{response: response, err: err}
+	}()
+
+	timer := time.NewTimer(policy.Delay)
+	defer timer.Stop()
+
+	inFlight := 1
+	for {
+		select {
+		case <-timer.C:
+			inFlight++
+			if policy.Stats != nil {
+				policy.Stats.hedged.Add(1)
+			}
+
+			rotate := 0
+			if policy.UseAlternateBackend {
+				rotate = 1
+			}
+
+			go func() {
+				if err := o.rateLimiter.Wait(ctx, endpoint); err != nil {
+					results <- hedgeResult{err: err, hedge: true}
+					return
+				}
+
+				response, err := o.doGetWithFailover(ctx, endpoint, path, rotate)
+				results <- hedgeResult{response: response, err: err, hedge: true}
+			}()
+		case result := <-results:
+			inFlight--
+
+			if result.err == nil {
+				if result.hedge && policy.Stats != nil {
+					policy.Stats.hedgeWins.Add(1)
+				}
+				return result.response, nil
+			}
+
+			if inFlight == 0 {
+				return httpResponse{}, result.err
+			}
+		}
+	}
+}
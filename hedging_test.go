@@ -0,0 +1,115 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+const quoteResponse = `{"amount_in":{"denom":"uosmo","amount":"1000000"},"amount_out":"42"}`
+
+// newSlowServer returns a server that answers quote requests after the given delays, in order,
+// and immediately once the delays are exhausted.
+func newSlowServer(t *testing.T, delays ...time.Duration) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		if call <= len(delays) {
+			select {
+			case <-time.After(delays[call-1]):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		_, _ = w.Write([]byte(quoteResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestHedging_HedgeWins(t *testing.T) {
+	server, calls := newSlowServer(t, time.Second)
+
+	stats := &sqsclient.HedgingStats{}
+	sqs, err := sqsclient.Initialize(
+		sqsclient.WithCustomURL(server.URL),
+		sqsclient.WithHedgingOpt(sqsclient.HedgingPolicy{Delay: 20 * time.Millisecond, Stats: stats}),
+	)
+	require.NoError(t, err)
+
+	start := time.Now()
+	quote, err := sqs.GetQuote(context.Background(), sqsclient.WithOutGivenIn(1000000, uosmoDenom, uionDenom))
+	require.NoError(t, err)
+	require.Equal(t, "42", quote.AmountOut.Amount)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, uint64(1), stats.Requests())
+	require.Equal(t, uint64(1), stats.Hedged())
+	require.Equal(t, uint64(1), stats.HedgeWins())
+}
+
+func TestHedging_NoHedgeWhenFast(t *testing.T) {
+	server, calls := newSlowServer(t)
+
+	stats := &sqsclient.HedgingStats{}
+	sqs, err := sqsclient.Initialize(
+		sqsclient.WithCustomURL(server.URL),
+		sqsclient.WithHedgingOpt(sqsclient.HedgingPolicy{Delay: time.Second, Stats: stats}),
+	)
+	require.NoError(t, err)
+
+	_, err = sqs.GetQuote(context.Background(), sqsclient.WithOutGivenIn(1000000, uosmoDenom, uionDenom))
+	require.NoError(t, err)
+
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, uint64(1), stats.Requests())
+	require.Equal(t, uint64(0), stats.Hedged())
+}
+
+func TestHedging_AlternateBackend(t *testing.T) {
+	primary, primaryCalls := newSlowServer(t, time.Second, time.Second)
+	secondary, secondaryCalls := newSlowServer(t)
+
+	stats := &sqsclient.HedgingStats{}
+	sqs, err := sqsclient.Initialize(
+		sqsclient.WithCustomURL(primary.URL),
+		sqsclient.WithFailoverURLsOpt(secondary.URL),
+		sqsclient.WithHedgingOpt(sqsclient.HedgingPolicy{Delay: 20 * time.Millisecond, UseAlternateBackend: true, Stats: stats}),
+	)
+	require.NoError(t, err)
+
+	_, err = sqs.GetQuote(context.Background(), sqsclient.WithOutGivenIn(1000000, uosmoDenom, uionDenom))
+	require.NoError(t, err)
+
+	require.Equal(t, int32(1), primaryCalls.Load())
+	require.Equal(t, int32(1), secondaryCalls.Load())
+	require.Equal(t, uint64(1), stats.HedgeWins())
+}
+
+func TestHedging_OnlyQuotes(t *testing.T) {
+	server, calls := newSlowServer(t, 100*time.Millisecond)
+
+	stats := &sqsclient.HedgingStats{}
+	sqs, err := sqsclient.Initialize(
+		sqsclient.WithCustomURL(server.URL),
+		sqsclient.WithHedgingOpt(sqsclient.HedgingPolicy{Delay: 10 * time.Millisecond, Stats: stats}),
+	)
+	require.NoError(t, err)
+
+	_, err = sqs.GetTokensMetadata(context.Background())
+	require.Error(t, err) // the quote response is not token metadata
+
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, uint64(0), stats.Requests())
+}
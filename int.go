@@ -0,0 +1,173 @@
+package sqsclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// Int is an arbitrary-precision integer, as used by SQS for token amounts in base units.
+// The zero value is 0.
+type Int struct {
+	i *big.Int
+}
+
+// ZeroInt returns 0.
+func ZeroInt() Int {
+	return Int{i: new(big.Int)}
+}
+
+// NewInt returns the Int of the given integer.
+func NewInt(i int64) Int {
+	return Int{i: big.NewInt(i)}
+}
+
+// NewIntFromBigInt returns the Int of a copy of the given big.Int. A nil big.Int is 0.
+func NewIntFromBigInt(i *big.Int) Int {
+	if i == nil {
+		return ZeroInt()
+	}
+	return Int{i: new(big.Int).Set(i)}
+}
+
+// NewIntFromStr parses a base 10 integer string, e.g. "-12345".
+func NewIntFromStr(str string) (Int, error) {
+	i, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return Int{}, fmt.Errorf("invalid integer string %q", str)
+	}
+	return Int{i: i}, nil
+}
+
+// MustNewIntFromStr is like NewIntFromStr but panics on error.
+func MustNewIntFromStr(str string) Int {
+	i, err := NewIntFromStr(str)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// bigInt returns the integer, treating the zero value as 0.
+func (i Int) bigInt() *big.Int {
+	if i.i == nil {
+		return new(big.Int)
+	}
+	return i.i
+}
+
+// BigInt returns a copy of the integer as a big.Int.
+func (i Int) BigInt() *big.Int {
+	return new(big.Int).Set(i.bigInt())
+}
+
+// IsZero returns true if the integer is 0.
+func (i Int) IsZero() bool {
+	return i.bigInt().Sign() == 0
+}
+
+// IsNegative returns true if the integer is lower than 0.
+func (i Int) IsNegative() bool {
+	return i.bigInt().Sign() < 0
+}
+
+// IsPositive returns true if the integer is greater than 0.
+func (i Int) IsPositive() bool {
+	return i.bigInt().Sign() > 0
+}
+
+// Cmp returns -1, 0 or 1 if i is lower than, equal to or greater than i2.
+func (i Int) Cmp(i2 Int) int {
+	return i.bigInt().Cmp(i2.bigInt())
+}
+
+// Equal returns true if i and i2 are equal.
+func (i Int) Equal(i2 Int) bool {
+	return i.Cmp(i2) == 0
+}
+
+// GT returns true if i is greater than i2.
+func (i Int) GT(i2 Int) bool {
+	return i.Cmp(i2) > 0
+}
+
+// GTE returns true if i is greater than or equal to i2.
+func (i Int) GTE(i2 Int) bool {
+	return i.Cmp(i2) >= 0
+}
+
+// LT returns true if i is lower than i2.
+func (i Int) LT(i2 Int) bool {
+	return i.Cmp(i2) < 0
+}
+
+// LTE returns true if i is lower than or equal to i2.
+func (i Int) LTE(i2 Int) bool {
+	return i.Cmp(i2) <= 0
+}
+
+// Neg returns -i.
+func (i Int) Neg() Int {
+	return Int{i: new(big.Int).Neg(i.bigInt())}
+}
+
+// Add returns i + i2.
+func (i Int) Add(i2 Int) Int {
+	return Int{i: new(big.Int).Add(i.bigInt(), i2.bigInt())}
+}
+
+// Sub returns i - i2.
+func (i Int) Sub(i2 Int) Int {
+	return Int{i: new(big.Int).Sub(i.bigInt(), i2.bigInt())}
+}
+
+// Mul returns i * i2.
+func (i Int) Mul(i2 Int) Int {
+	return Int{i: new(big.Int).Mul(i.bigInt(), i2.bigInt())}
+}
+
+// Quo returns i / i2, truncated towards zero. It panics if i2 is zero.
+func (i Int) Quo(i2 Int) Int {
+	return Int{i: new(big.Int).Quo(i.bigInt(), i2.bigInt())}
+}
+
+// ToDec returns the integer as a Dec.
+func (i Int) ToDec() Dec {
+	return NewDecFromInt(i)
+}
+
+// String returns the integer in base 10.
+func (i Int) String() string {
+	return i.bigInt().String()
+}
+
+// MarshalJSON implements json.Marshaler. The integer is encoded as a string.
+func (i Int) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts both strings and numbers.
+// Null and empty strings decode as 0.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == `""` {
+		*i = Int{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		var number json.Number
+		if err := json.Unmarshal(data, &number); err != nil {
+			return fmt.Errorf("integer must be a string or a number: %w", err)
+		}
+		str = number.String()
+	}
+
+	parsed, err := NewIntFromStr(str)
+	if err != nil {
+		return err
+	}
+
+	*i = parsed
+	return nil
+}
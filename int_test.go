@@ -0,0 +1,58 @@
+package sqsclient_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestInt(t *testing.T) {
+	i := sqsclient.MustNewIntFromStr
+
+	require.Equal(t, "1000000000000000000000000000001", i("1000000000000000000000000000000").Add(sqsclient.NewInt(1)).String())
+	require.True(t, i("5").Sub(i("7")).Equal(sqsclient.NewInt(-2)))
+	require.True(t, i("5").Mul(i("-7")).Equal(sqsclient.NewInt(-35)))
+	require.True(t, i("-7").Quo(i("2")).Equal(sqsclient.NewInt(-3)))
+	require.True(t, i("7").Neg().IsNegative())
+	require.True(t, i("7").IsPositive())
+	require.True(t, sqsclient.Int{}.IsZero())
+	require.True(t, i("2").GT(i("1")))
+	require.True(t, i("2").GTE(i("2")))
+	require.True(t, i("1").LT(i("2")))
+	require.True(t, i("2").LTE(i("2")))
+	require.True(t, i("3").ToDec().Equal(sqsclient.MustNewDecFromStr("3")))
+
+	// BigInt returns a copy.
+	three := i("3")
+	three.BigInt().SetInt64(4)
+	require.Equal(t, "3", three.String())
+	require.Equal(t, "3", sqsclient.NewIntFromBigInt(big.NewInt(3)).String())
+	require.True(t, sqsclient.NewIntFromBigInt(nil).IsZero())
+
+	_, err := sqsclient.NewIntFromStr("1.5")
+	require.Error(t, err)
+	_, err = sqsclient.NewIntFromStr("")
+	require.Error(t, err)
+}
+
+func TestInt_JSON(t *testing.T) {
+	var i sqsclient.Int
+	require.NoError(t, json.Unmarshal([]byte(`"123456789012345678901234567890"`), &i))
+	require.Equal(t, "123456789012345678901234567890", i.String())
+
+	require.NoError(t, json.Unmarshal([]byte(`42`), &i))
+	require.Equal(t, "42", i.String())
+
+	bz, err := json.Marshal(i)
+	require.NoError(t, err)
+	require.Equal(t, `"42"`, string(bz))
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), &i))
+	require.True(t, i.IsZero())
+
+	require.Error(t, json.Unmarshal([]byte(`"4.2"`), &i))
+}
@@ -0,0 +1,180 @@
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultMetadataCacheTTL is the time token metadata is fresh when MetadataCacheConfig.TTL is zero.
+const DefaultMetadataCacheTTL = 5 * time.Minute
+
+// MetadataCacheConfig configures a MetadataCache.
+type MetadataCacheConfig struct {
+	// TTL is how long fetched token metadata is fresh.
+	// If zero, DefaultMetadataCacheTTL is used.
+	TTL time.Duration
+	// MaxStaleness is how long after TTL stale token metadata is still returned while
+	// it is refreshed in the background. Past it, callers wait for fresh metadata.
+	// If zero, stale metadata is always returned while it is refreshed.
+	MaxStaleness time.Duration
+	// FetchTimeout is the timeout of the requests made by the cache, which are shared by
+	// the callers waiting for them. If zero, DefaultRequestTimeout is used.
+	FetchTimeout time.Duration
+}
+
+// Validate validates the MetadataCacheConfig.
+func (c *MetadataCacheConfig) Validate() error {
+	if c.TTL < 0 || c.MaxStaleness < 0 || c.FetchTimeout < 0 {
+		return errors.New("metadata cache durations cannot be negative")
+	}
+
+	return nil
+}
+
+// MetadataCache is an SQSClient that caches the token metadata returned by GetTokensMetadata
+// in memory, per set of options. Concurrent fetches of the same token metadata are deduplicated
+// and stale metadata is refreshed in the background. Other methods call the wrapped client.
+//
+// The returned token metadata is shared between callers and must not be modified.
+type MetadataCache struct {
+	SQSClient
+
+	config MetadataCacheConfig
+	stats  CacheStats
+
+	mu      sync.Mutex
+	entries map[string]metadataCacheEntry
+	// generation is incremented by Invalidate so that fetches started before are not cached.
+	generation uint64
+	// refreshing are the keys being refreshed in the background.
+	refreshing map[string]struct{}
+
+	flights flightGroup[map[string]OsmosisTokenMetadata]
+}
+
+// metadataCacheEntry is token metadata cached by a MetadataCache.
+type metadataCacheEntry struct {
+	metadata  map[string]OsmosisTokenMetadata
+	fetchedAt time.Time
+}
+
+// NewMetadataCache wraps the client with a token metadata cache.
+func NewMetadataCache(client SQSClient, config MetadataCacheConfig) (*MetadataCache, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if config.TTL == 0 {
+		config.TTL = DefaultMetadataCacheTTL
+	}
+
+	if config.FetchTimeout == 0 {
+		config.FetchTimeout = DefaultRequestTimeout
+	}
+
+	return &MetadataCache{
+		SQSClient:  client,
+		config:     config,
+		entries:    make(map[string]metadataCacheEntry),
+		refreshing: make(map[string]struct{}),
+	}, nil
+}
+
+// GetTokensMetadata implements SQSClient
+func (c *MetadataCache) GetTokensMetadata(ctx context.Context, options ...TokensMetadataOption) (map[string]OsmosisTokenMetadata, error) {
+	opts := TokensMetadataOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+	key := opts.CreateQueryParams().Encode()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	generation := c.generation
+	c.mu.Unlock()
+
+	if ok {
+		age := time.Since(entry.fetchedAt)
+		if age < c.config.TTL {
+			c.stats.hits.Add(1)
+			return entry.metadata, nil
+		}
+
+		if c.config.MaxStaleness == 0 || age < c.config.TTL+c.config.MaxStaleness {
+			c.stats.staleHits.Add(1)
+			c.refresh(key, generation, options)
+			return entry.metadata, nil
+		}
+	}
+
+	c.stats.misses.Add(1)
+	return c.fetch(ctx, key, generation, options)
+}
+
+// Invalidate removes all the cached token metadata.
+func (c *MetadataCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]metadataCacheEntry)
+	c.generation++
+
+	// Later calls must not get the token metadata being fetched.
+	c.flights.forget()
+}
+
+// Stats returns the statistics of the cache.
+func (c *MetadataCache) Stats() *CacheStats {
+	return &c.stats
+}
+
+// fetch gets the token metadata from the wrapped client, deduplicating concurrent fetches,
+// and caches it unless the cache was invalidated in the meantime. It returns early with the
+// error of ctx if ctx is done first, without cancelling the fetch shared with other callers.
+func (c *MetadataCache) fetch(ctx context.Context, key string, generation uint64, options []TokensMetadataOption) (map[string]OsmosisTokenMetadata, error) {
+	return c.flights.do(ctx, key, func() (map[string]OsmosisTokenMetadata, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.config.FetchTimeout)
+		defer cancel()
+
+		metadata, err := c.SQSClient.GetTokensMetadata(ctx, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		if c.generation == generation {
+			c.entries[key] = metadataCacheEntry{metadata: metadata, fetchedAt: time.Now()}
+		}
+		c.mu.Unlock()
+
+		return metadata, nil
+	})
+}
+
+// refresh fetches the token metadata in the background unless it is already being refreshed.
+func (c *MetadataCache) refresh(key string, generation uint64, options []TokensMetadataOption) {
+	c.mu.Lock()
+	if _, ok := c.refreshing[key]; ok {
+		c.mu.Unlock()
+		return
+	}
+	c.refreshing[key] = struct{}{}
+	c.mu.Unlock()
+
+	c.stats.refreshes.Add(1)
+	go func() {
+		defer func() {
+			c.mu.Lock()
+			delete(c.refreshing, key)
+			c.mu.Unlock()
+		}()
+
+		if _, err := c.fetch(context.Background(), key, generation, options); err != nil {
+			c.stats.refreshErrors.Add(1)
+		}
+	}()
+}
+
+var _ SQSClient = &MetadataCache{}
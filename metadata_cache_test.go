@@ -0,0 +1,224 @@
+package sqsclient_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+	"github.com/osmosis-labs/sqs-go-client/sqsmock"
+)
+
+// newMetadataMock returns a mock returning token metadata with the number of calls as decimals,
+// after the given delay.
+func newMetadataMock(delay time.Duration) (*sqsmock.SQSMock, *atomic.Int32) {
+	var calls atomic.Int32
+	mock := &sqsmock.SQSMock{
+		GetTokensMetadataFunc: func(ctx context.Context, options ...sqsclient.TokensMetadataOption) (map[string]sqsclient.OsmosisTokenMetadata, error) {
+			call := calls.Add(1)
+			time.Sleep(delay)
+			return map[string]sqsclient.OsmosisTokenMetadata{uosmoDenom: {Symbol: "OSMO", Decimals: int(call)}}, nil
+		},
+	}
+	return mock, &calls
+}
+
+func TestMetadataCache_Hit(t *testing.T) {
+	mock, calls := newMetadataMock(0)
+
+	cache, err := sqsclient.NewMetadataCache(mock, sqsclient.MetadataCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		metadata, err := cache.GetTokensMetadata(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, metadata[uosmoDenom].Decimals)
+	}
+
+	// Different options are cached separately.
+	_, err = cache.GetTokensMetadata(ctx, sqsclient.WithMetadataDenoms(uosmoDenom))
+	require.NoError(t, err)
+
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, uint64(2), cache.Stats().Hits())
+	require.Equal(t, uint64(2), cache.Stats().Misses())
+}
+
+func TestMetadataCache_Deduplicates(t *testing.T) {
+	mock, calls := newMetadataMock(50 * time.Millisecond)
+
+	cache, err := sqsclient.NewMetadataCache(mock, sqsclient.MetadataCacheConfig{})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cache.GetTokensMetadata(context.Background())
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestMetadataCache_StaleWhileRevalidate(t *testing.T) {
+	mock, calls := newMetadataMock(0)
+
+	cache, err := sqsclient.NewMetadataCache(mock, sqsclient.MetadataCacheConfig{TTL: 20 * time.Millisecond})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = cache.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+
+	// The stale metadata is returned while it is refreshed.
+	metadata, err := cache.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, metadata[uosmoDenom].Decimals)
+	require.Equal(t, uint64(1), cache.Stats().StaleHits())
+
+	require.Eventually(t, func() bool {
+		metadata, err := cache.GetTokensMetadata(ctx)
+		return err == nil && metadata[uosmoDenom].Decimals == 2
+	}, time.Second, 5*time.Millisecond)
+
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, uint64(1), cache.Stats().Refreshes())
+}
+
+func TestMetadataCache_MaxStaleness(t *testing.T) {
+	mock, calls := newMetadataMock(0)
+
+	cache, err := sqsclient.NewMetadataCache(mock, sqsclient.MetadataCacheConfig{TTL: 10 * time.Millisecond, MaxStaleness: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = cache.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+
+	// Too stale to be returned.
+	metadata, err := cache.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, metadata[uosmoDenom].Decimals)
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, uint64(0), cache.Stats().StaleHits())
+}
+
+func TestMetadataCache_Invalidate(t *testing.T) {
+	mock, calls := newMetadataMock(0)
+
+	cache, err := sqsclient.NewMetadataCache(mock, sqsclient.MetadataCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = cache.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+
+	cache.Invalidate()
+
+	metadata, err := cache.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, metadata[uosmoDenom].Decimals)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestMetadataCache_ErrorsAreNotCached(t *testing.T) {
+	errSQS := errors.New("sqs is down")
+	mock := &sqsmock.SQSMock{Err: errSQS}
+
+	cache, err := sqsclient.NewMetadataCache(mock, sqsclient.MetadataCacheConfig{})
+	require.NoError(t, err)
+
+	_, err = cache.GetTokensMetadata(context.Background())
+	require.ErrorIs(t, err, errSQS)
+
+	mock.Err = nil
+	mock.GetTokensMetadataFunc = func(ctx context.Context, options ...sqsclient.TokensMetadataOption) (map[string]sqsclient.OsmosisTokenMetadata, error) {
+		return map[string]sqsclient.OsmosisTokenMetadata{}, nil
+	}
+
+	_, err = cache.GetTokensMetadata(context.Background())
+	require.NoError(t, err)
+
+	// Other methods call the wrapped client.
+	mock.Err = errSQS
+	_, err = cache.GetPools(context.Background())
+	require.ErrorIs(t, err, errSQS)
+}
+
+func TestMetadataCache_WaitersKeepTheirContext(t *testing.T) {
+	mock, calls := newMetadataMock(50 * time.Millisecond)
+	getTokensMetadata := mock.GetTokensMetadataFunc
+	mock.GetTokensMetadataFunc = func(ctx context.Context, options ...sqsclient.TokensMetadataOption) (map[string]sqsclient.OsmosisTokenMetadata, error) {
+		metadata, err := getTokensMetadata(ctx, options...)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return metadata, err
+	}
+
+	cache, err := sqsclient.NewMetadataCache(mock, sqsclient.MetadataCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	// The first caller gives up before the fetch completes.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	errs := make(chan error)
+	go func() {
+		_, err := cache.GetTokensMetadata(ctx)
+		errs <- err
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+
+	// The second caller shares the fetch, which is not cancelled with the first caller.
+	metadata, err := cache.GetTokensMetadata(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, metadata[uosmoDenom].Decimals)
+
+	require.ErrorIs(t, <-errs, context.DeadlineExceeded)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestMetadataCache_InvalidateDuringFetch(t *testing.T) {
+	mock, calls := newMetadataMock(50 * time.Millisecond)
+
+	cache, err := sqsclient.NewMetadataCache(mock, sqsclient.MetadataCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	go func() {
+		_, _ = cache.GetTokensMetadata(ctx)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cache.Invalidate()
+
+	// Callers after Invalidate do not get the metadata fetched before.
+	metadata, err := cache.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, metadata[uosmoDenom].Decimals)
+	require.Equal(t, int32(2), calls.Load())
+
+	metadata, err = cache.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, metadata[uosmoDenom].Decimals)
+}
@@ -9,3 +9,11 @@ type Options interface {
 	// CreateQueryParams creates the query params for the options.
 	CreateQueryParams() url.Values
 }
+
+// PathParamsOptions is implemented by the options of endpoints with path parameters,
+// such as the pool ID of /router/spot-price-pool/{id}.
+type PathParamsOptions interface {
+	Options
+	// CreatePath creates the request path by filling in the path parameters of the endpoint.
+	CreatePath(endpoint string) string
+}
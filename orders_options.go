@@ -0,0 +1,26 @@
+package sqsclient
+
+import (
+	"net/url"
+)
+
+// OrdersOptions is the type for the options for the /pools/all-orders
+// and /pools/order-history endpoints.
+type OrdersOptions struct {
+	// Address is the Osmosis address that placed the orders.
+	Address string
+}
+
+// Validate validates the options for the /pools/all-orders and /pools/order-history endpoints.
+func (opts *OrdersOptions) Validate() error {
+	return ValidateBech32Address(opts.Address, OsmosisBech32Prefix)
+}
+
+// CreateQueryParams creates the query params for the /pools/all-orders and /pools/order-history endpoints.
+func (opts *OrdersOptions) CreateQueryParams() url.Values {
+	queryParams := url.Values{}
+	queryParams.Add("userOsmoAddress", opts.Address)
+	return queryParams
+}
+
+var _ Options = &OrdersOptions{}
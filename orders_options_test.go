@@ -0,0 +1,114 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+	"github.com/osmosis-labs/sqs-go-client/sqsmock"
+)
+
+const osmoAddress = "osmo1q8709l2656zjtg567xnrxjr6j35a2pvwhxxms2"
+
+const ordersResponse = `{
+	"orders": [{
+		"order_id": 42,
+		"tick_id": -100,
+		"orderbookAddress": "osmo1orderbook",
+		"owner": "osmo1q8709l2656zjtg567xnrxjr6j35a2pvwhxxms2",
+		"order_direction": "bid",
+		"status": "partiallyFilled",
+		"price": "0.5",
+		"quantity": "500",
+		"placed_quantity": "1000",
+		"placed_at": 1700000000,
+		"totalFilled": 500,
+		"percentFilled": "50",
+		"percentClaimed": "0",
+		"output": "1000",
+		"base_asset": {"symbol": "OSMO", "decimals": 6},
+		"quote_asset": {"symbol": "USDC", "decimals": 6}
+	}],
+	"is_best_effort": false
+}`
+
+func TestGetOrders(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		get      func(sqs sqsclient.SQSClient) (sqsclient.LimitOrders, error)
+	}{
+		{
+			name:     "active orders",
+			endpoint: "/pools/all-orders",
+			get: func(sqs sqsclient.SQSClient) (sqsclient.LimitOrders, error) {
+				return sqs.GetActiveOrders(context.Background(), osmoAddress)
+			},
+		},
+		{
+			name:     "historical orders",
+			endpoint: "/pools/order-history",
+			get: func(sqs sqsclient.SQSClient) (sqsclient.LimitOrders, error) {
+				return sqs.GetHistoricalOrders(context.Background(), osmoAddress)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, tc.endpoint, r.URL.Path)
+				require.Equal(t, osmoAddress, r.URL.Query().Get("userOsmoAddress"))
+				_, _ = w.Write([]byte(ordersResponse))
+			}))
+			defer server.Close()
+
+			sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+			require.NoError(t, err)
+
+			orders, err := tc.get(sqs)
+			require.NoError(t, err)
+			require.Len(t, orders.Orders, 1)
+
+			order := orders.Orders[0]
+			require.Equal(t, int64(42), order.OrderID)
+			require.Equal(t, int64(-100), order.TickID)
+			require.Equal(t, sqsclient.OrderDirectionBid, order.OrderDirection)
+			require.Equal(t, sqsclient.OrderStatusPartiallyFilled, order.Status)
+			require.Equal(t, sqsclient.MustNewDecFromStr("1000"), order.PlacedQuantity)
+			require.Equal(t, sqsclient.MustNewDecFromStr("500"), order.TotalFilled)
+			require.Equal(t, "OSMO", order.BaseAsset.Symbol)
+		})
+	}
+}
+
+func TestGetOrders_InvalidAddress(t *testing.T) {
+	sqs, err := sqsclient.Initialize()
+	require.NoError(t, err)
+
+	_, err = sqs.GetActiveOrders(context.Background(), "cosmos1q8709l2656zjtg567xnrxjr6j35a2pvwhxxms2")
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+
+	_, err = sqs.GetHistoricalOrders(context.Background(), "")
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+
+	// Bad checksum.
+	_, err = sqs.GetActiveOrders(context.Background(), "osmo1q8709l2656zjtg567xnrxjr6j35a2pvwhxxms3")
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
+
+func TestMockGetActiveOrders(t *testing.T) {
+	mock := &sqsmock.SQSMock{
+		GetActiveOrdersFunc: func(ctx context.Context, address string) (sqsclient.LimitOrders, error) {
+			return sqsclient.LimitOrders{Orders: []sqsclient.LimitOrder{{OrderID: 1, Owner: address}}}, nil
+		},
+	}
+
+	orders, err := mock.GetActiveOrders(context.Background(), osmoAddress)
+	require.NoError(t, err)
+	require.Equal(t, osmoAddress, orders.Orders[0].Owner)
+}
@@ -0,0 +1,52 @@
+package sqsclient
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PoolSpotPriceOptions is the type for the options for the /router/spot-price-pool/{id} endpoint.
+type PoolSpotPriceOptions struct {
+	// PoolID is the ID of the pool to get the spot price from.
+	PoolID uint64
+
+	// BaseDenom is the denom to get the price of.
+	BaseDenom string
+
+	// QuoteDenom is the denom the price is expressed in.
+	QuoteDenom string
+}
+
+// Validate validates the options for the /router/spot-price-pool/{id} endpoint.
+func (opts *PoolSpotPriceOptions) Validate() error {
+	if opts.PoolID == 0 {
+		return fmt.Errorf("pool id is required")
+	}
+
+	if opts.BaseDenom == "" || opts.QuoteDenom == "" {
+		return fmt.Errorf("base denom and quote denom are required")
+	}
+
+	if opts.BaseDenom == opts.QuoteDenom {
+		return fmt.Errorf("base denom and quote denom must be different")
+	}
+
+	return nil
+}
+
+// CreateQueryParams creates the query params for the /router/spot-price-pool/{id} endpoint.
+func (opts *PoolSpotPriceOptions) CreateQueryParams() url.Values {
+	queryParams := url.Values{}
+	queryParams.Add("baseAsset", opts.BaseDenom)
+	queryParams.Add("quoteAsset", opts.QuoteDenom)
+	return queryParams
+}
+
+// CreatePath creates the path for the /router/spot-price-pool/{id} endpoint.
+func (opts *PoolSpotPriceOptions) CreatePath(endpoint string) string {
+	return strings.Replace(endpoint, "{id}", strconv.FormatUint(opts.PoolID, 10), 1)
+}
+
+var _ PathParamsOptions = &PoolSpotPriceOptions{}
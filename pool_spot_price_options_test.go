@@ -0,0 +1,45 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestGetPoolSpotPrice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/router/spot-price-pool/1", r.URL.Path)
+		require.Equal(t, uosmoDenom, r.URL.Query().Get("baseAsset"))
+		require.Equal(t, atomDenom, r.URL.Query().Get("quoteAsset"))
+		_, _ = w.Write([]byte(`{"spot_price":"0.042000000000000000000000000000000000"}`))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	spotPrice, err := sqs.GetPoolSpotPrice(context.Background(), 1, uosmoDenom, atomDenom)
+	require.NoError(t, err)
+	require.Equal(t, sqsclient.MustNewDecFromStr("0.042"), spotPrice)
+}
+
+func TestGetPoolSpotPrice_Validation(t *testing.T) {
+	sqs, err := sqsclient.Initialize()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = sqs.GetPoolSpotPrice(ctx, 0, uosmoDenom, atomDenom)
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+
+	_, err = sqs.GetPoolSpotPrice(ctx, 1, uosmoDenom, "")
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+
+	_, err = sqs.GetPoolSpotPrice(ctx, 1, uosmoDenom, uosmoDenom)
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
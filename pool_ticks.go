@@ -0,0 +1,101 @@
+package sqsclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// exponentAtPriceOne is the exponent of the price increment per tick
+// at price one in Osmosis concentrated liquidity pools.
+const exponentAtPriceOne = -6
+
+// geometricExponentIncrementDistanceInTicks is the number of ticks after which
+// the price increment per tick grows tenfold.
+const geometricExponentIncrementDistanceInTicks = 9_000_000
+
+const (
+	// MinTick is the minimum tick of an Osmosis concentrated liquidity pool, at price 10^-30.
+	MinTick int64 = -270_000_000
+	// MaxTick is the maximum tick of an Osmosis concentrated liquidity pool, at price 10^38.
+	MaxTick int64 = 342_000_000
+)
+
+// TickToPrice converts a tick of an Osmosis concentrated liquidity pool to the
+// price of token0 in terms of token1, in base units. Ticks are clamped to
+// [MinTick, MaxTick].
+func TickToPrice(tick int64) Dec {
+	tick = max(MinTick, min(tick, MaxTick))
+
+	if tick == 0 {
+		return Dec{i: pow10(DecPrecision)}
+	}
+
+	geometricExponentDelta := tick / geometricExponentIncrementDistanceInTicks
+	exponentAtCurrentTick := exponentAtPriceOne + geometricExponentDelta
+	if tick < 0 {
+		exponentAtCurrentTick--
+	}
+
+	numAdditiveTicks := tick - geometricExponentDelta*geometricExponentIncrementDistanceInTicks
+
+	// price = 10^geometricExponentDelta + numAdditiveTicks * 10^exponentAtCurrentTick
+	//       = (10^(geometricExponentDelta-exponentAtCurrentTick) + numAdditiveTicks) * 10^exponentAtCurrentTick,
+	// scaled by 10^DecPrecision last since the exponent is below -DecPrecision at MinTick.
+	price := pow10(int(geometricExponentDelta - exponentAtCurrentTick))
+	price.Add(price, big.NewInt(numAdditiveTicks))
+
+	return Dec{i: price}.mulPow10(DecPrecision + int(exponentAtCurrentTick))
+}
+
+// PriceRanges converts the tick ranges to prices of token0 in terms of token1,
+// adjusted for the decimals of the tokens found in the given token metadata,
+// as returned by GetTokensMetadata.
+func (t PoolTicks) PriceRanges(tokensMetadata map[string]OsmosisTokenMetadata) ([]TickPriceRange, error) {
+	token0Decimals, err := tokenDecimals(tokensMetadata, t.Token0)
+	if err != nil {
+		return nil, err
+	}
+
+	token1Decimals, err := tokenDecimals(tokensMetadata, t.Token1)
+	if err != nil {
+		return nil, err
+	}
+
+	decimalsDelta := token0Decimals - token1Decimals
+
+	priceRanges := make([]TickPriceRange, 0, len(t.Ticks))
+	for _, tickRange := range t.Ticks {
+		priceRanges = append(priceRanges, TickPriceRange{
+			LowerPrice:      TickToPrice(tickRange.LowerTick).mulPow10(decimalsDelta),
+			UpperPrice:      TickToPrice(tickRange.UpperTick).mulPow10(decimalsDelta),
+			LiquidityAmount: tickRange.LiquidityAmount,
+		})
+	}
+
+	return priceRanges, nil
+}
+
+// tokenDecimals returns the decimals of the denom from the token metadata.
+func tokenDecimals(tokensMetadata map[string]OsmosisTokenMetadata, denom string) (int, error) {
+	if metadata, ok := tokensMetadata[denom]; ok {
+		return metadata.Decimals, nil
+	}
+
+	for _, metadata := range tokensMetadata {
+		if metadata.CoinMinimalDenom == denom {
+			return metadata.Decimals, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no token metadata for denom %s", denom)
+}
+
+// concentratedPoolChainModel is the subset of the chain model of a concentrated
+// liquidity pool needed to interpret its ticks.
+type concentratedPoolChainModel struct {
+	Token0           string      `json:"token0"`
+	Token1           string      `json:"token1"`
+	CurrentTick      json.Number `json:"current_tick"`
+	CurrentSqrtPrice Dec         `json:"current_sqrt_price"`
+}
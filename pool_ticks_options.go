@@ -0,0 +1,35 @@
+package sqsclient
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PoolTicksOptions is the type for the options for the /pools/ticks/{id} endpoint.
+type PoolTicksOptions struct {
+	// PoolID is the ID of the concentrated liquidity pool to get the ticks of.
+	PoolID uint64
+}
+
+// Validate validates the options for the /pools/ticks/{id} endpoint.
+func (opts *PoolTicksOptions) Validate() error {
+	if opts.PoolID == 0 {
+		return fmt.Errorf("pool id is required")
+	}
+
+	return nil
+}
+
+// CreateQueryParams creates the query params for the /pools/ticks/{id} endpoint.
+func (opts *PoolTicksOptions) CreateQueryParams() url.Values {
+	return url.Values{}
+}
+
+// CreatePath creates the path for the /pools/ticks/{id} endpoint.
+func (opts *PoolTicksOptions) CreatePath(endpoint string) string {
+	return strings.Replace(endpoint, "{id}", strconv.FormatUint(opts.PoolID, 10), 1)
+}
+
+var _ PathParamsOptions = &PoolTicksOptions{}
@@ -0,0 +1,95 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestTickToPrice(t *testing.T) {
+	tests := []struct {
+		tick          int64
+		expectedPrice string
+	}{
+		{tick: 0, expectedPrice: "1"},
+		{tick: 1, expectedPrice: "1.000001"},
+		{tick: -1, expectedPrice: "0.9999999"},
+		{tick: 9_000_000, expectedPrice: "10"},
+		{tick: -9_000_000, expectedPrice: "0.1"},
+		{tick: 38_000_000, expectedPrice: "30000"},
+		{tick: -108_000_000, expectedPrice: "0.000000000001"},
+		{tick: -153_000_001, expectedPrice: "0.0000000000000000099999990"},
+		{tick: sqsclient.MinTick + 1, expectedPrice: "0.000000000000000000000000000001000001"},
+		{tick: sqsclient.MinTick, expectedPrice: "0.000000000000000000000000000001"},
+		{tick: sqsclient.MinTick - 1, expectedPrice: "0.000000000000000000000000000001"},
+		{tick: -500_000_000, expectedPrice: "0.000000000000000000000000000001"},
+		{tick: sqsclient.MaxTick, expectedPrice: "100000000000000000000000000000000000000"},
+		{tick: sqsclient.MaxTick + 1, expectedPrice: "100000000000000000000000000000000000000"},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, sqsclient.MustNewDecFromStr(tc.expectedPrice), sqsclient.TickToPrice(tc.tick), "tick %d", tc.tick)
+	}
+}
+
+func TestPoolTicks_PriceRanges(t *testing.T) {
+	ticks := sqsclient.PoolTicks{
+		Ticks: []sqsclient.TickRange{
+			{LowerTick: -9_000_000, UpperTick: 9_000_000, LiquidityAmount: sqsclient.MustNewDecFromStr("100.5")},
+		},
+		Token0: "weth-wei",
+		Token1: uosmoDenom,
+	}
+
+	tokensMetadata := map[string]sqsclient.OsmosisTokenMetadata{
+		"weth-wei": {CoinMinimalDenom: "weth-wei", Decimals: 18},
+		"OSMO":     {CoinMinimalDenom: uosmoDenom, Decimals: 6},
+	}
+
+	priceRanges, err := ticks.PriceRanges(tokensMetadata)
+	require.NoError(t, err)
+	require.Equal(t, []sqsclient.TickPriceRange{
+		{
+			LowerPrice:      sqsclient.MustNewDecFromStr("100000000000"),
+			UpperPrice:      sqsclient.MustNewDecFromStr("10000000000000"),
+			LiquidityAmount: sqsclient.MustNewDecFromStr("100.5"),
+		},
+	}, priceRanges)
+
+	delete(tokensMetadata, "OSMO")
+	_, err = ticks.PriceRanges(tokensMetadata)
+	require.Error(t, err)
+}
+
+func TestGetPoolTicks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pools/ticks/1135":
+			_, _ = w.Write([]byte(`{"ticks":[{"lower_tick":-100,"upper_tick":100,"liquidity_amount":"12.5"}],"current_tick_index":0}`))
+		case "/pools":
+			require.Equal(t, "1135", r.URL.Query().Get("filter[id]"))
+			_, _ = w.Write([]byte(`[{"chain_model":{"id":"1135","token0":"uosmo","token1":"uion","current_tick":"-42","current_sqrt_price":"0.999978999779497684860218470513005478"},"type":2}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	ticks, err := sqs.GetPoolTicks(context.Background(), 1135)
+	require.NoError(t, err)
+	require.Equal(t, []sqsclient.TickRange{
+		{LowerTick: -100, UpperTick: 100, LiquidityAmount: sqsclient.MustNewDecFromStr("12.5")},
+	}, ticks.Ticks)
+	require.Equal(t, int64(-42), ticks.CurrentTick)
+	require.Equal(t, sqsclient.MustNewDecFromStr("0.999978999779497684860218470513005478"), ticks.CurrentSqrtPrice)
+	require.Equal(t, uosmoDenom, ticks.Token0)
+	require.Equal(t, uionDenom, ticks.Token1)
+}
@@ -0,0 +1,174 @@
+package sqsclient
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PoolType is the type of an Osmosis pool.
+type PoolType int32
+
+const (
+	BalancerPool     PoolType = 0
+	StableswapPool   PoolType = 1
+	ConcentratedPool PoolType = 2
+	CosmWasmPool     PoolType = 3
+)
+
+// PoolsOptions is the type for the options for the /pools endpoint.
+type PoolsOptions struct {
+	// PoolIDs is a list of pool IDs to get. If empty, all pools are returned.
+	PoolIDs []uint64
+
+	// PoolTypes is a list of pool types to filter by.
+	PoolTypes []PoolType
+
+	// Denoms is a list of denoms that the pools must contain.
+	Denoms []string
+
+	// MinLiquidityCap is the minimum liquidity capitalization of the pools, in USD.
+	MinLiquidityCap uint64
+
+	// WithMarketIncentives is whether to only return pools with market incentives.
+	WithMarketIncentives bool
+
+	// PageNumber is the page to get, starting at 1. Requires PageSize.
+	PageNumber int
+	// PageSize is the number of pools per page.
+	PageSize int
+
+	// SortBy is the field to sort the pools by, e.g. "liquidity_cap".
+	SortBy string
+	// SortDescending is whether to sort the pools in descending order.
+	SortDescending bool
+}
+
+// PoolsOption is the type for the options for the /pools endpoint.
+type PoolsOption func(opts *PoolsOptions)
+
+// WithPoolIDs is an option to get the given pools from the /pools endpoint.
+func WithPoolIDs(poolIDs ...uint64) PoolsOption {
+	return func(opts *PoolsOptions) {
+		opts.PoolIDs = poolIDs
+	}
+}
+
+// WithPoolTypes is an option to filter the /pools endpoint by pool type.
+func WithPoolTypes(poolTypes ...PoolType) PoolsOption {
+	return func(opts *PoolsOptions) {
+		opts.PoolTypes = poolTypes
+	}
+}
+
+// WithPoolDenoms is an option to filter the /pools endpoint by pools containing the given denoms.
+func WithPoolDenoms(denoms ...string) PoolsOption {
+	return func(opts *PoolsOptions) {
+		opts.Denoms = denoms
+	}
+}
+
+// WithMinLiquidityCap is an option to filter the /pools endpoint by minimum liquidity capitalization.
+func WithMinLiquidityCap(minLiquidityCap uint64) PoolsOption {
+	return func(opts *PoolsOptions) {
+		opts.MinLiquidityCap = minLiquidityCap
+	}
+}
+
+// WithMarketIncentives is an option to only get pools with market incentives from the /pools endpoint.
+func WithMarketIncentives() PoolsOption {
+	return func(opts *PoolsOptions) {
+		opts.WithMarketIncentives = true
+	}
+}
+
+// WithPagination is an option to paginate the /pools endpoint.
+// Page numbers start at 1.
+func WithPagination(pageNumber int, pageSize int) PoolsOption {
+	return func(opts *PoolsOptions) {
+		opts.PageNumber = pageNumber
+		opts.PageSize = pageSize
+	}
+}
+
+// WithSortBy is an option to sort the pools returned by the /pools endpoint by the given field.
+func WithSortBy(field string, descending bool) PoolsOption {
+	return func(opts *PoolsOptions) {
+		opts.SortBy = field
+		opts.SortDescending = descending
+	}
+}
+
+// Validate validates the options for the /pools endpoint.
+func (opts *PoolsOptions) Validate() error {
+	for _, poolType := range opts.PoolTypes {
+		if poolType < BalancerPool || poolType > CosmWasmPool {
+			return fmt.Errorf("invalid pool type %d", poolType)
+		}
+	}
+
+	if opts.PageNumber < 0 || opts.PageSize < 0 {
+		return fmt.Errorf("page number and page size cannot be negative")
+	}
+
+	if opts.PageNumber > 0 && opts.PageSize == 0 {
+		return fmt.Errorf("page size is required when page number is set")
+	}
+
+	if opts.SortDescending && opts.SortBy == "" {
+		return fmt.Errorf("sort field is required when sorting in descending order")
+	}
+
+	return nil
+}
+
+// CreateQueryParams creates the query params for the /pools endpoint.
+func (opts *PoolsOptions) CreateQueryParams() url.Values {
+	queryParams := url.Values{}
+
+	if len(opts.PoolIDs) > 0 {
+		poolIDs := make([]string, len(opts.PoolIDs))
+		for i, id := range opts.PoolIDs {
+			poolIDs[i] = strconv.FormatUint(id, 10)
+		}
+		queryParams.Add("filter[id]", strings.Join(poolIDs, ","))
+	}
+
+	if len(opts.PoolTypes) > 0 {
+		poolTypes := make([]string, len(opts.PoolTypes))
+		for i, poolType := range opts.PoolTypes {
+			poolTypes[i] = strconv.FormatInt(int64(poolType), 10)
+		}
+		queryParams.Add("filter[type]", strings.Join(poolTypes, ","))
+	}
+
+	if len(opts.Denoms) > 0 {
+		queryParams.Add("filter[denom]", strings.Join(opts.Denoms, ","))
+	}
+
+	if opts.MinLiquidityCap > 0 {
+		queryParams.Add("filter[min_liquidity_cap]", strconv.FormatUint(opts.MinLiquidityCap, 10))
+	}
+
+	if opts.WithMarketIncentives {
+		queryParams.Add("filter[with_market_incentives]", "true")
+	}
+
+	if opts.PageSize > 0 {
+		queryParams.Add("page[number]", strconv.Itoa(max(opts.PageNumber, 1)))
+		queryParams.Add("page[size]", strconv.Itoa(opts.PageSize))
+	}
+
+	if opts.SortBy != "" {
+		if opts.SortDescending {
+			queryParams.Add("sort", "-"+opts.SortBy)
+		} else {
+			queryParams.Add("sort", opts.SortBy)
+		}
+	}
+
+	return queryParams
+}
+
+var _ Options = &PoolsOptions{}
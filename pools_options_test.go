@@ -0,0 +1,94 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestPoolsOptions_CreateQueryParams(t *testing.T) {
+	options := &sqsclient.PoolsOptions{}
+	for _, opt := range []sqsclient.PoolsOption{
+		sqsclient.WithPoolIDs(1, 1135),
+		sqsclient.WithPoolTypes(sqsclient.ConcentratedPool, sqsclient.CosmWasmPool),
+		sqsclient.WithPoolDenoms(uosmoDenom, atomDenom),
+		sqsclient.WithMinLiquidityCap(1000),
+		sqsclient.WithMarketIncentives(),
+		sqsclient.WithPagination(2, 50),
+		sqsclient.WithSortBy("liquidity_cap", true),
+	} {
+		opt(options)
+	}
+
+	require.NoError(t, options.Validate())
+
+	queryParams := options.CreateQueryParams()
+	require.Equal(t, "1,1135", queryParams.Get("filter[id]"))
+	require.Equal(t, "2,3", queryParams.Get("filter[type]"))
+	require.Equal(t, uosmoDenom+","+atomDenom, queryParams.Get("filter[denom]"))
+	require.Equal(t, "1000", queryParams.Get("filter[min_liquidity_cap]"))
+	require.Equal(t, "true", queryParams.Get("filter[with_market_incentives]"))
+	require.Equal(t, "2", queryParams.Get("page[number]"))
+	require.Equal(t, "50", queryParams.Get("page[size]"))
+	require.Equal(t, "-liquidity_cap", queryParams.Get("sort"))
+}
+
+func TestPoolsOptions_Validate(t *testing.T) {
+	options := &sqsclient.PoolsOptions{PoolTypes: []sqsclient.PoolType{7}}
+	require.Error(t, options.Validate())
+
+	options = &sqsclient.PoolsOptions{PageNumber: 1}
+	require.Error(t, options.Validate())
+}
+
+func TestGetPools_Response(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+
+		expectedTotalItems uint64
+	}{
+		{
+			name: "paginated",
+			body: `{"data":[{"chain_model":{"pool_id":"1135"},"balances":[{"denom":"uosmo","amount":"100"}],"type":2,"spread_factor":"0.002","liquidity_cap":"1000","apr_data":{"total":{"lower":1.5,"upper":3}}}],"meta":{"total_items":10}}`,
+
+			expectedTotalItems: 10,
+		},
+		{
+			name: "plain list",
+			body: `[{"chain_model":{"id":"1135"},"balances":[{"denom":"uosmo","amount":"100"}],"type":2,"spread_factor":"0.002","liquidity_cap":"1000","apr_data":{"total":{"lower":1.5,"upper":3}}}]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/pools", r.URL.Path)
+				require.Equal(t, "1135", r.URL.Query().Get("filter[id]"))
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+			require.NoError(t, err)
+
+			pools, err := sqs.GetPools(context.Background(), sqsclient.WithPoolIDs(1135))
+			require.NoError(t, err)
+			require.Len(t, pools.Data, 1)
+			require.Equal(t, tc.expectedTotalItems, pools.Meta.TotalItems)
+
+			pool := pools.Data[0]
+			id, err := pool.ID()
+			require.NoError(t, err)
+			require.Equal(t, uint64(1135), id)
+			require.Equal(t, sqsclient.ConcentratedPool, pool.Type)
+			require.Equal(t, []sqsclient.Coin{{Denom: "uosmo", Amount: "100"}}, pool.Balances)
+			require.Equal(t, 3.0, pool.APRData.Total.Upper)
+		})
+	}
+}
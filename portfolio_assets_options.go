@@ -0,0 +1,29 @@
+package sqsclient
+
+import (
+	"net/url"
+	"strings"
+)
+
+// PortfolioAssetsOptions is the type for the options for the /passthrough/portfolio-assets/{address} endpoint.
+type PortfolioAssetsOptions struct {
+	// Address is the Osmosis address to get the portfolio assets of.
+	Address string
+}
+
+// Validate validates the options for the /passthrough/portfolio-assets/{address} endpoint.
+func (opts *PortfolioAssetsOptions) Validate() error {
+	return ValidateBech32Address(opts.Address, OsmosisBech32Prefix)
+}
+
+// CreateQueryParams creates the query params for the /passthrough/portfolio-assets/{address} endpoint.
+func (opts *PortfolioAssetsOptions) CreateQueryParams() url.Values {
+	return url.Values{}
+}
+
+// CreatePath creates the path for the /passthrough/portfolio-assets/{address} endpoint.
+func (opts *PortfolioAssetsOptions) CreatePath(endpoint string) string {
+	return strings.Replace(endpoint, "{address}", url.PathEscape(opts.Address), 1)
+}
+
+var _ PathParamsOptions = &PortfolioAssetsOptions{}
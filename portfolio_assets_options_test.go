@@ -0,0 +1,72 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+const portfolioAssetsResponse = `{
+	"categories": {
+		"in-locks": {"capitalization": "", "account_coins_result": [], "is_best_effort": true},
+		"pooled": {"capitalization": "0", "account_coins_result": [], "is_best_effort": false},
+		"staked": {
+			"capitalization": "100",
+			"account_coins_result": [{"coin": {"denom": "uosmo", "amount": "200000000"}, "cap_value": "100"}],
+			"is_best_effort": false
+		},
+		"total-assets": {
+			"capitalization": "250.5",
+			"account_coins_result": [{"coin": {"denom": "uosmo", "amount": "300000000"}, "cap_value": "150.5"}],
+			"is_best_effort": true
+		},
+		"unclaimed-rewards": {"capitalization": "0", "account_coins_result": [], "is_best_effort": false},
+		"unstaking": {"capitalization": "0", "account_coins_result": [], "is_best_effort": false},
+		"user-balances": {
+			"capitalization": "150.5",
+			"account_coins_result": [{"coin": {"denom": "uosmo", "amount": "100000000"}, "cap_value": "50.5"}],
+			"is_best_effort": false
+		}
+	}
+}`
+
+func TestGetPortfolioAssets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/passthrough/portfolio-assets/"+osmoAddress, r.URL.Path)
+		_, _ = w.Write([]byte(portfolioAssetsResponse))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	assets, err := sqs.GetPortfolioAssets(context.Background(), osmoAddress)
+	require.NoError(t, err)
+
+	bank := assets.Bank()
+	require.Equal(t, sqsclient.MustNewDecFromStr("150.5").String(), bank.Capitalization.String())
+	require.Len(t, bank.AccountCoins, 1)
+	require.Equal(t, sqsclient.Coin{Denom: uosmoDenom, Amount: "100000000"}, bank.AccountCoins[0].Coin)
+	require.Equal(t, sqsclient.MustNewDecFromStr("50.5").String(), bank.AccountCoins[0].CapValue.String())
+
+	require.Equal(t, sqsclient.MustNewDecFromStr("100").String(), assets.Staked().Capitalization.String())
+	require.True(t, assets.InLocks().Capitalization.IsZero())
+	require.True(t, assets.InLocks().IsBestEffort)
+	require.True(t, assets.Total().IsBestEffort)
+
+	require.True(t, assets.Pooled().Capitalization.IsZero())
+	require.Empty(t, assets.Unstaking().AccountCoins)
+}
+
+func TestGetPortfolioAssets_InvalidAddress(t *testing.T) {
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL("http://localhost:0"))
+	require.NoError(t, err)
+
+	_, err = sqs.GetPortfolioAssets(context.Background(), "cosmos1ys0e5e5et3erqadkxjkz7s9ma60jqk9udl7kqn")
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
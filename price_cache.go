@@ -0,0 +1,253 @@
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultPriceCacheMaxAge is how long cached prices are served when PriceCacheConfig.MaxAge is zero.
+const DefaultPriceCacheMaxAge = time.Second
+
+// PriceCacheConfig configures a PriceCache.
+type PriceCacheConfig struct {
+	// MaxAge is how long a fetched price is served from the cache.
+	// If zero, DefaultPriceCacheMaxAge is used.
+	MaxAge time.Duration
+	// BatchWindow is how long missing prices are collected before they are fetched, so that
+	// concurrent GetPrices calls for different base denoms are merged into a single request.
+	// If zero, missing prices are fetched right away and only calls for the same base denoms
+	// are merged.
+	BatchWindow time.Duration
+	// FetchTimeout is the timeout of the requests made by the cache, which are shared by
+	// the callers waiting for them. If zero, DefaultRequestTimeout is used.
+	FetchTimeout time.Duration
+}
+
+// Validate validates the PriceCacheConfig.
+func (c *PriceCacheConfig) Validate() error {
+	if c.MaxAge < 0 || c.BatchWindow < 0 || c.FetchTimeout < 0 {
+		return errors.New("price cache durations cannot be negative")
+	}
+
+	return nil
+}
+
+// PriceCache is an SQSClient that caches the prices returned by GetPrices in memory, per
+// base denom. Prices missing from the cache are fetched for all concurrent callers at once:
+// a base denom being fetched for a caller is not fetched again for another, and with a
+// BatchWindow, the base denoms of concurrent callers are fetched in a single request.
+// Other methods call the wrapped client.
+//
+// Cache hits and misses are counted per base denom. The returned prices are shared between
+// callers and must not be modified.
+type PriceCache struct {
+	SQSClient
+
+	config PriceCacheConfig
+	stats  CacheStats
+
+	mu      sync.Mutex
+	entries map[priceCacheKey]priceCacheEntry
+	// pending are the batches collecting base denoms, per options variant.
+	pending map[string]*priceBatch
+	// inFlight are the batches fetching or about to fetch each base denom.
+	inFlight map[priceCacheKey]*priceBatch
+	// generation is incremented by Invalidate so that fetches started before are not cached.
+	generation uint64
+}
+
+// priceCacheKey identifies the prices of a base denom for an options variant,
+// i.e. the options other than the base denoms.
+type priceCacheKey struct {
+	variant   string
+	baseDenom string
+}
+
+// priceCacheEntry are the cached prices of a base denom, by quote denom.
+type priceCacheEntry struct {
+	prices    map[string]string
+	fetchedAt time.Time
+}
+
+// priceBatch is a request for the prices of several base denoms, shared by the callers waiting for it.
+type priceBatch struct {
+	variant    string
+	opts       TokenPricesOptions
+	baseDenoms []string
+
+	done   chan struct{}
+	prices Prices
+	err    error
+}
+
+// NewPriceCache wraps the client with a price cache.
+func NewPriceCache(client SQSClient, config PriceCacheConfig) (*PriceCache, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if config.MaxAge == 0 {
+		config.MaxAge = DefaultPriceCacheMaxAge
+	}
+
+	if config.FetchTimeout == 0 {
+		config.FetchTimeout = DefaultRequestTimeout
+	}
+
+	return &PriceCache{
+		SQSClient: client,
+		config:    config,
+		entries:   make(map[priceCacheKey]priceCacheEntry),
+		pending:   make(map[string]*priceBatch),
+		inFlight:  make(map[priceCacheKey]*priceBatch),
+	}, nil
+}
+
+// GetPrices implements SQSClient
+func (c *PriceCache) GetPrices(ctx context.Context, options ...TokenPricesOption) (Prices, error) {
+	opts := TokenPricesOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	// Let the wrapped client report invalid options.
+	if err := opts.Validate(); err != nil {
+		return c.SQSClient.GetPrices(ctx, options...)
+	}
+
+	variant := priceCacheVariant(opts)
+	prices := make(Prices, len(opts.BaseDenoms))
+	batches := make(map[*priceBatch][]string)
+
+	c.mu.Lock()
+	for _, baseDenom := range opts.BaseDenoms {
+		key := priceCacheKey{variant: variant, baseDenom: baseDenom}
+
+		if entry, ok := c.entries[key]; ok && time.Since(entry.fetchedAt) < c.config.MaxAge {
+			c.stats.hits.Add(1)
+			prices[baseDenom] = entry.prices
+			continue
+		}
+
+		c.stats.misses.Add(1)
+
+		batch, ok := c.inFlight[key]
+		if !ok {
+			batch = c.addToPendingBatch(variant, opts, baseDenom)
+			c.inFlight[key] = batch
+		}
+		batches[batch] = append(batches[batch], baseDenom)
+	}
+	c.mu.Unlock()
+
+	for batch, baseDenoms := range batches {
+		select {
+		case <-batch.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if batch.err != nil {
+			return nil, batch.err
+		}
+
+		for _, baseDenom := range baseDenoms {
+			if quotes, ok := batch.prices[baseDenom]; ok {
+				prices[baseDenom] = quotes
+			}
+		}
+	}
+
+	return prices, nil
+}
+
+// Invalidate removes all the cached prices.
+func (c *PriceCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[priceCacheKey]priceCacheEntry)
+	c.generation++
+
+	// Later calls must not get the prices being fetched.
+	c.pending = make(map[string]*priceBatch)
+	c.inFlight = make(map[priceCacheKey]*priceBatch)
+}
+
+// Stats returns the statistics of the cache.
+func (c *PriceCache) Stats() *CacheStats {
+	return &c.stats
+}
+
+// addToPendingBatch adds the base denom to the pending batch of the variant, creating
+// and scheduling the batch if there is none.
+// CONTRACT: c.mu must be held.
+func (c *PriceCache) addToPendingBatch(variant string, opts TokenPricesOptions, baseDenom string) *priceBatch {
+	batch, ok := c.pending[variant]
+	if !ok {
+		batch = &priceBatch{variant: variant, opts: opts, done: make(chan struct{})}
+		c.pending[variant] = batch
+
+		go c.fetch(batch, c.generation)
+	}
+
+	batch.baseDenoms = append(batch.baseDenoms, baseDenom)
+	return batch
+}
+
+// fetch waits for the batch window, then gets the prices of the batch from the wrapped client
+// and caches them unless the cache was invalidated in the meantime.
+func (c *PriceCache) fetch(batch *priceBatch, generation uint64) {
+	if c.config.BatchWindow > 0 {
+		time.Sleep(c.config.BatchWindow)
+	}
+
+	// Stop collecting base denoms.
+	c.mu.Lock()
+	if c.pending[batch.variant] == batch {
+		delete(c.pending, batch.variant)
+	}
+	baseDenoms := batch.baseDenoms
+	c.mu.Unlock()
+
+	sort.Strings(baseDenoms)
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.FetchTimeout)
+	defer cancel()
+
+	batch.prices, batch.err = c.SQSClient.GetPrices(ctx, func(opts *TokenPricesOptions) {
+		*opts = batch.opts
+		opts.BaseDenoms = baseDenoms
+	})
+
+	now := time.Now()
+
+	c.mu.Lock()
+	for _, baseDenom := range baseDenoms {
+		key := priceCacheKey{variant: batch.variant, baseDenom: baseDenom}
+
+		// After Invalidate, the key may be fetched by a newer batch.
+		if c.inFlight[key] == batch {
+			delete(c.inFlight, key)
+		}
+
+		if quotes, ok := batch.prices[baseDenom]; ok && batch.err == nil && c.generation == generation {
+			c.entries[key] = priceCacheEntry{prices: quotes, fetchedAt: now}
+		}
+	}
+	c.mu.Unlock()
+
+	close(batch.done)
+}
+
+// priceCacheVariant returns the query params of the options other than the base denoms.
+func priceCacheVariant(opts TokenPricesOptions) string {
+	queryParams := opts.CreateQueryParams()
+	queryParams.Del("base")
+	return queryParams.Encode()
+}
+
+var _ SQSClient = &PriceCache{}
@@ -0,0 +1,223 @@
+package sqsclient_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+	"github.com/osmosis-labs/sqs-go-client/sqsmock"
+)
+
+// priceRecorder is a mock GetPrices that records the base denoms of each call.
+type priceRecorder struct {
+	mu    sync.Mutex
+	calls [][]string
+	delay time.Duration
+}
+
+func (r *priceRecorder) getPrices(ctx context.Context, options ...sqsclient.TokenPricesOption) (map[string]map[string]string, error) {
+	opts := sqsclient.TokenPricesOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	r.mu.Lock()
+	r.calls = append(r.calls, opts.BaseDenoms)
+	r.mu.Unlock()
+
+	time.Sleep(r.delay)
+
+	prices := make(map[string]map[string]string, len(opts.BaseDenoms))
+	for _, baseDenom := range opts.BaseDenoms {
+		prices[baseDenom] = map[string]string{usdcDenom: "1." + opts.QuoteDenom}
+	}
+	return prices, nil
+}
+
+func (r *priceRecorder) recordedCalls() [][]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+func TestPriceCache_ServesFreshPrices(t *testing.T) {
+	recorder := &priceRecorder{}
+	cache, err := sqsclient.NewPriceCache(&sqsmock.SQSMock{GetPricesFunc: recorder.getPrices}, sqsclient.PriceCacheConfig{MaxAge: time.Minute})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	prices, err := cache.GetPrices(ctx, sqsclient.WithBaseDenoms([]string{uosmoDenom, atomDenom}))
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+
+	// Only the missing base denom is fetched.
+	prices, err = cache.GetPrices(ctx, sqsclient.WithBaseDenoms([]string{atomDenom, uionDenom}))
+	require.NoError(t, err)
+	require.Equal(t, sqsclient.Prices{atomDenom: {usdcDenom: "1."}, uionDenom: {usdcDenom: "1."}}, prices)
+
+	// Other options are cached separately.
+	_, err = cache.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom), sqsclient.WithPricingSource(sqsclient.PricingSourceCoinGecko))
+	require.NoError(t, err)
+
+	require.Equal(t, [][]string{{atomDenom, uosmoDenom}, {uionDenom}, {uosmoDenom}}, recorder.recordedCalls())
+	require.Equal(t, uint64(1), cache.Stats().Hits())
+	require.Equal(t, uint64(4), cache.Stats().Misses())
+}
+
+func TestPriceCache_Expires(t *testing.T) {
+	recorder := &priceRecorder{}
+	cache, err := sqsclient.NewPriceCache(&sqsmock.SQSMock{GetPricesFunc: recorder.getPrices}, sqsclient.PriceCacheConfig{MaxAge: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = cache.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom))
+	require.NoError(t, err)
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, err = cache.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom))
+	require.NoError(t, err)
+	require.Len(t, recorder.recordedCalls(), 2)
+
+	cache.Invalidate()
+
+	_, err = cache.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom))
+	require.NoError(t, err)
+	require.Len(t, recorder.recordedCalls(), 3)
+}
+
+func TestPriceCache_DeduplicatesOverlappingDenoms(t *testing.T) {
+	recorder := &priceRecorder{delay: 50 * time.Millisecond}
+	cache, err := sqsclient.NewPriceCache(&sqsmock.SQSMock{GetPricesFunc: recorder.getPrices}, sqsclient.PriceCacheConfig{})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			prices, err := cache.GetPrices(ctx, sqsclient.WithBaseDenoms([]string{uosmoDenom, atomDenom}))
+			require.NoError(t, err)
+			require.Len(t, prices, 2)
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, recorder.recordedCalls(), 1)
+}
+
+func TestPriceCache_BatchWindow(t *testing.T) {
+	recorder := &priceRecorder{}
+	cache, err := sqsclient.NewPriceCache(&sqsmock.SQSMock{GetPricesFunc: recorder.getPrices}, sqsclient.PriceCacheConfig{BatchWindow: 50 * time.Millisecond})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	baseDenoms := [][]string{{uosmoDenom}, {uosmoDenom, atomDenom}, {uionDenom}, {usdcDenom, uionDenom}}
+
+	var wg sync.WaitGroup
+	for _, denoms := range baseDenoms {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			prices, err := cache.GetPrices(ctx, sqsclient.WithBaseDenoms(denoms))
+			require.NoError(t, err)
+
+			// Each caller only gets the prices it asked for.
+			require.Len(t, prices, len(denoms))
+			for _, denom := range denoms {
+				require.Contains(t, prices, denom)
+			}
+		}()
+	}
+	wg.Wait()
+
+	calls := recorder.recordedCalls()
+	require.Len(t, calls, 1)
+
+	expected := []string{uosmoDenom, atomDenom, uionDenom, usdcDenom}
+	sort.Strings(expected)
+	require.Equal(t, expected, calls[0])
+}
+
+func TestPriceCache_Errors(t *testing.T) {
+	errSQS := errors.New("sqs is down")
+	cache, err := sqsclient.NewPriceCache(&sqsmock.SQSMock{Err: errSQS}, sqsclient.PriceCacheConfig{})
+	require.NoError(t, err)
+
+	_, err = cache.GetPrices(context.Background(), sqsclient.WithBaseDenom(uosmoDenom))
+	require.ErrorIs(t, err, errSQS)
+
+	// Invalid options are passed to the wrapped client.
+	_, err = cache.GetPrices(context.Background())
+	require.ErrorIs(t, err, errSQS)
+
+	_, err = sqsclient.NewPriceCache(&sqsmock.SQSMock{}, sqsclient.PriceCacheConfig{MaxAge: -1})
+	require.Error(t, err)
+}
+
+func TestPriceCache_ContextCancelled(t *testing.T) {
+	recorder := &priceRecorder{delay: time.Second}
+	cache, err := sqsclient.NewPriceCache(&sqsmock.SQSMock{GetPricesFunc: recorder.getPrices}, sqsclient.PriceCacheConfig{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = cache.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestPriceCache_InvalidateDuringFetch(t *testing.T) {
+	var calls atomic.Int32
+	mock := &sqsmock.SQSMock{
+		GetPricesFunc: func(ctx context.Context, options ...sqsclient.TokenPricesOption) (map[string]map[string]string, error) {
+			call := calls.Add(1)
+			// The batch started before Invalidate completes first.
+			time.Sleep(time.Duration(call) * 50 * time.Millisecond)
+			return map[string]map[string]string{uosmoDenom: {usdcDenom: strconv.Itoa(int(call))}}, nil
+		},
+	}
+
+	cache, err := sqsclient.NewPriceCache(mock, sqsclient.PriceCacheConfig{MaxAge: time.Minute})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	option := sqsclient.WithBaseDenom(uosmoDenom)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = cache.GetPrices(ctx, option)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cache.Invalidate()
+
+	// Callers after Invalidate do not join the batch started before.
+	results := make(chan sqsclient.Prices)
+	go func() {
+		prices, err := cache.GetPrices(ctx, option)
+		require.NoError(t, err)
+		results <- prices
+	}()
+
+	// Once the old batch completes, callers still join the newer batch.
+	<-done
+	latest, err := cache.GetPrices(ctx, option)
+	require.NoError(t, err)
+	require.Equal(t, "2", latest[uosmoDenom][usdcDenom])
+	require.Equal(t, "2", (<-results)[uosmoDenom][usdcDenom])
+	require.Equal(t, int32(2), calls.Load())
+}
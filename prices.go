@@ -0,0 +1,99 @@
+package sqsclient
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// USDCDenom is the chain denom of USDC on Osmosis, the default quote denom of GetPrices.
+	USDCDenom = "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4"
+	// USDCHumanDenom is the human denom of USDC, the quote denom of GetPrices with human denoms.
+	USDCHumanDenom = "usdc"
+)
+
+// Prices are the prices returned by GetPrices, keyed by base denom and then quote denom.
+// It can be indexed like the map returned by GetPrices before, or used through its methods.
+type Prices map[string]map[string]string
+
+// PriceEntry is a price of Prices.
+type PriceEntry struct {
+	BaseDenom  string
+	QuoteDenom string
+	Price      string
+}
+
+// Price returns the price of the base denom in the quote denom.
+// It returns an error wrapping ErrPriceNotFound if there is no such price.
+func (p Prices) Price(baseDenom, quoteDenom string) (Dec, error) {
+	price, ok := p[baseDenom][quoteDenom]
+	if !ok {
+		return Dec{}, fmt.Errorf("%w: %s in %s", ErrPriceNotFound, baseDenom, quoteDenom)
+	}
+
+	parsed, err := NewDecFromStr(price)
+	if err != nil {
+		return Dec{}, fmt.Errorf("invalid price of %s in %s: %w", baseDenom, quoteDenom, err)
+	}
+
+	return parsed, nil
+}
+
+// USD returns the price of the base denom in USDC, by chain or human denom.
+// It returns an error wrapping ErrPriceNotFound if there is no such price.
+func (p Prices) USD(baseDenom string) (Dec, error) {
+	if _, ok := p[baseDenom][USDCDenom]; ok {
+		return p.Price(baseDenom, USDCDenom)
+	}
+
+	if _, ok := p[baseDenom][USDCHumanDenom]; ok {
+		return p.Price(baseDenom, USDCHumanDenom)
+	}
+
+	return Dec{}, fmt.Errorf("%w: %s in USD", ErrPriceNotFound, baseDenom)
+}
+
+// BaseDenoms returns the base denoms with prices, sorted.
+func (p Prices) BaseDenoms() []string {
+	baseDenoms := make([]string, 0, len(p))
+	for baseDenom := range p {
+		baseDenoms = append(baseDenoms, baseDenom)
+	}
+	sort.Strings(baseDenoms)
+	return baseDenoms
+}
+
+// QuoteDenoms returns the quote denoms the base denom is priced in, sorted.
+func (p Prices) QuoteDenoms(baseDenom string) []string {
+	quoteDenoms := make([]string, 0, len(p[baseDenom]))
+	for quoteDenom := range p[baseDenom] {
+		quoteDenoms = append(quoteDenoms, quoteDenom)
+	}
+	sort.Strings(quoteDenoms)
+	return quoteDenoms
+}
+
+// Entries returns all the prices, sorted by base denom and then quote denom.
+func (p Prices) Entries() []PriceEntry {
+	var entries []PriceEntry
+	for _, baseDenom := range p.BaseDenoms() {
+		for _, quoteDenom := range p.QuoteDenoms(baseDenom) {
+			entries = append(entries, PriceEntry{
+				BaseDenom:  baseDenom,
+				QuoteDenom: quoteDenom,
+				Price:      p[baseDenom][quoteDenom],
+			})
+		}
+	}
+	return entries
+}
+
+// Decimals parses all the prices. See ParsePrices.
+func (p Prices) Decimals() (map[string]map[string]Dec, error) {
+	return ParsePrices(p)
+}
+
+// Raw returns the prices as the nested map returned by SQS.
+func (p Prices) Raw() map[string]map[string]string {
+	return p
+}
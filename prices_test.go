@@ -0,0 +1,79 @@
+package sqsclient_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+	"github.com/osmosis-labs/sqs-go-client/sqsmock"
+)
+
+func TestPrices(t *testing.T) {
+	prices := sqsclient.Prices{
+		uosmoDenom: {usdcDenom: "0.5", atomDenom: "0.1"},
+		atomDenom:  {usdcDenom: "5"},
+		"osmo":     {sqsclient.USDCHumanDenom: "0.5"},
+	}
+
+	price, err := prices.Price(uosmoDenom, atomDenom)
+	require.NoError(t, err)
+	require.True(t, price.Equal(sqsclient.MustNewDecFromStr("0.1")))
+
+	price, err = prices.USD(atomDenom)
+	require.NoError(t, err)
+	require.True(t, price.Equal(sqsclient.MustNewDecFromStr("5")))
+
+	price, err = prices.USD("osmo")
+	require.NoError(t, err)
+	require.True(t, price.Equal(sqsclient.MustNewDecFromStr("0.5")))
+
+	_, err = prices.Price(atomDenom, uosmoDenom)
+	require.ErrorIs(t, err, sqsclient.ErrPriceNotFound)
+
+	_, err = prices.USD(uionDenom)
+	require.ErrorIs(t, err, sqsclient.ErrPriceNotFound)
+
+	require.Equal(t, []string{atomDenom, "osmo", uosmoDenom}, prices.BaseDenoms())
+	require.Equal(t, []string{atomDenom, usdcDenom}, prices.QuoteDenoms(uosmoDenom))
+	require.Equal(t, []sqsclient.PriceEntry{
+		{BaseDenom: atomDenom, QuoteDenom: usdcDenom, Price: "5"},
+		{BaseDenom: "osmo", QuoteDenom: sqsclient.USDCHumanDenom, Price: "0.5"},
+		{BaseDenom: uosmoDenom, QuoteDenom: atomDenom, Price: "0.1"},
+		{BaseDenom: uosmoDenom, QuoteDenom: usdcDenom, Price: "0.5"},
+	}, prices.Entries())
+
+	decimals, err := prices.Decimals()
+	require.NoError(t, err)
+	require.True(t, decimals[atomDenom][usdcDenom].Equal(sqsclient.MustNewDecFromStr("5")))
+
+	var raw map[string]map[string]string = prices.Raw()
+	require.Equal(t, "5", raw[atomDenom][usdcDenom])
+}
+
+func TestPrices_Invalid(t *testing.T) {
+	prices := sqsclient.Prices{uosmoDenom: {usdcDenom: "n/a"}}
+
+	_, err := prices.USD(uosmoDenom)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, sqsclient.ErrPriceNotFound)
+
+	_, err = prices.Decimals()
+	require.Error(t, err)
+}
+
+func TestSQSMock_GetPrices(t *testing.T) {
+	mock := &sqsmock.SQSMock{
+		GetPricesFunc: func(ctx context.Context, options ...sqsclient.TokenPricesOption) (map[string]map[string]string, error) {
+			return map[string]map[string]string{uosmoDenom: {usdcDenom: "0.5"}}, nil
+		},
+	}
+
+	prices, err := mock.GetPrices(context.Background())
+	require.NoError(t, err)
+
+	price, err := prices.USD(uosmoDenom)
+	require.NoError(t, err)
+	require.True(t, price.Equal(sqsclient.MustNewDecFromStr("0.5")))
+}
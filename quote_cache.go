@@ -0,0 +1,272 @@
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultQuoteCacheTTL is how long quotes are cached when QuoteCacheConfig.TTL is zero.
+	DefaultQuoteCacheTTL = 2 * time.Second
+	// DefaultQuoteCacheMaxEntries is the maximum number of cached quotes when QuoteCacheConfig.MaxEntries is zero.
+	DefaultQuoteCacheMaxEntries = 10_000
+)
+
+// QuoteCacheConfig configures a QuoteCache.
+type QuoteCacheConfig struct {
+	// TTL is how long a quote is cached. Keep it short, as quotes change with every block.
+	// If zero, DefaultQuoteCacheTTL is used.
+	TTL time.Duration
+	// AmountSignificantDigits, if positive, is the number of significant digits of the
+	// amounts in the cache key. Quotes for amounts that only differ past these digits,
+	// e.g. 1234567 and 1239999 with 3 significant digits, share the same cache entry,
+	// so the returned quote may be for another amount of the bucket.
+	AmountSignificantDigits int
+	// MaxEntries is the maximum number of cached quotes.
+	// If zero, DefaultQuoteCacheMaxEntries is used.
+	MaxEntries int
+	// FetchTimeout is the timeout of the requests shared by concurrent identical calls.
+	// If zero, DefaultRequestTimeout is used.
+	FetchTimeout time.Duration
+}
+
+// Validate validates the QuoteCacheConfig.
+func (c *QuoteCacheConfig) Validate() error {
+	if c.TTL < 0 {
+		return errors.New("quote cache ttl cannot be negative")
+	}
+
+	if c.AmountSignificantDigits < 0 {
+		return errors.New("quote cache amount significant digits cannot be negative")
+	}
+
+	if c.MaxEntries < 0 {
+		return errors.New("quote cache max entries cannot be negative")
+	}
+
+	if c.FetchTimeout < 0 {
+		return errors.New("quote cache fetch timeout cannot be negative")
+	}
+
+	return nil
+}
+
+// quoteCacheBypassKey is the context key of ContextWithoutQuoteCache.
+type quoteCacheBypassKey struct{}
+
+// ContextWithoutQuoteCache returns a context for which a QuoteCache does not return cached quotes.
+// See WithBypassQuoteCache.
+func ContextWithoutQuoteCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, quoteCacheBypassKey{}, true)
+}
+
+// WithBypassQuoteCache is an option for a QuoteCache to get a fresh quote from SQS rather than
+// a cached one. The fresh quote is still cached for later calls. It has no effect on other clients.
+func WithBypassQuoteCache() RouterQuoteOption {
+	return func(opts *RouterQuoteOptions) {
+		opts.bypassCache = true
+	}
+}
+
+// QuoteCache is an SQSClient that caches the quotes returned by GetQuote in memory for a short TTL.
+// Quotes are keyed on a canonical encoding of the RouterQuoteOptions, e.g. without leading zeros
+// in the amounts. Concurrent identical calls share a single request. Other methods call the
+// wrapped client.
+type QuoteCache struct {
+	SQSClient
+
+	config QuoteCacheConfig
+	stats  CacheStats
+
+	mu      sync.Mutex
+	entries map[string]quoteCacheEntry
+	// generation is incremented by Invalidate so that fetches started before are not cached.
+	generation uint64
+
+	flights flightGroup[SQSQuoteResponse]
+}
+
+// quoteCacheEntry is a quote cached by a QuoteCache.
+type quoteCacheEntry struct {
+	quote     SQSQuoteResponse
+	expiresAt time.Time
+}
+
+// NewQuoteCache wraps the client with a quote cache.
+func NewQuoteCache(client SQSClient, config QuoteCacheConfig) (*QuoteCache, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if config.TTL == 0 {
+		config.TTL = DefaultQuoteCacheTTL
+	}
+
+	if config.MaxEntries == 0 {
+		config.MaxEntries = DefaultQuoteCacheMaxEntries
+	}
+
+	if config.FetchTimeout == 0 {
+		config.FetchTimeout = DefaultRequestTimeout
+	}
+
+	return &QuoteCache{
+		SQSClient: client,
+		config:    config,
+		entries:   make(map[string]quoteCacheEntry),
+	}, nil
+}
+
+// GetQuote implements SQSClient
+func (c *QuoteCache) GetQuote(ctx context.Context, options ...RouterQuoteOption) (SQSQuoteResponse, error) {
+	opts := RouterQuoteOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	// Let the wrapped client report invalid options.
+	if err := opts.Validate(); err != nil {
+		return c.SQSClient.GetQuote(ctx, options...)
+	}
+
+	key := c.cacheKey(opts)
+	bypass, _ := ctx.Value(quoteCacheBypassKey{}).(bool)
+	bypass = bypass || opts.bypassCache
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	generation := c.generation
+	c.mu.Unlock()
+
+	if !bypass {
+		if ok && time.Now().Before(entry.expiresAt) {
+			c.stats.hits.Add(1)
+			return entry.quote, nil
+		}
+
+		c.stats.misses.Add(1)
+		return c.flights.do(ctx, key, func() (SQSQuoteResponse, error) {
+			// The request is shared with the other callers, so it must not be cancelled with ctx.
+			ctx, cancel := context.WithTimeout(context.Background(), c.config.FetchTimeout)
+			defer cancel()
+
+			return c.fetch(ctx, key, generation, options)
+		})
+	}
+
+	c.stats.misses.Add(1)
+	return c.fetch(ctx, key, generation, options)
+}
+
+// GetQuotes implements SQSClient. The quotes are cached like those of GetQuote.
+func (c *QuoteCache) GetQuotes(ctx context.Context, requests []QuoteRequest, options ...BatchOption) ([]QuoteResult, error) {
+	return getQuotes(ctx, c.GetQuote, requests, options...)
+}
+
+// Invalidate removes all the cached quotes.
+func (c *QuoteCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]quoteCacheEntry)
+	c.generation++
+
+	// Later calls must not get the quotes being fetched.
+	c.flights.forget()
+}
+
+// Stats returns the statistics of the cache.
+func (c *QuoteCache) Stats() *CacheStats {
+	return &c.stats
+}
+
+// fetch gets the quote from the wrapped client and caches it unless the cache was invalidated in the meantime.
+func (c *QuoteCache) fetch(ctx context.Context, key string, generation uint64, options []RouterQuoteOption) (SQSQuoteResponse, error) {
+	quote, err := c.SQSClient.GetQuote(ctx, options...)
+	if err != nil {
+		return SQSQuoteResponse{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation != generation {
+		return quote, nil
+	}
+
+	now := time.Now()
+	if len(c.entries) >= c.config.MaxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = quoteCacheEntry{quote: quote, expiresAt: now.Add(c.config.TTL)}
+
+	return quote, nil
+}
+
+// evict removes the expired quotes, or an arbitrary quote if none has expired.
+// CONTRACT: c.mu must be held.
+func (c *QuoteCache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	for key := range c.entries {
+		if len(c.entries) < c.config.MaxEntries {
+			return
+		}
+		delete(c.entries, key)
+	}
+}
+
+// cacheKey returns the canonical encoding of the quote options.
+//
+// The order of the denoms and pool IDs is kept. The pool IDs and denoms of a custom direct
+// quote are the route, in order, and the quote is labelled with the first denom, so quotes
+// for the same denoms in another order are different quotes.
+func (c *QuoteCache) cacheKey(opts RouterQuoteOptions) string {
+	key := url.Values{}
+	key.Set("tokenIn", c.normalizeToken(opts.TokenIn))
+	key.Set("tokenOut", c.normalizeToken(opts.TokenOut))
+	key.Set("tokenInDenom", strings.Join(opts.TokenInDenom, ","))
+	key.Set("tokenOutDenom", strings.Join(opts.TokenOutDenom, ","))
+	key.Set("poolID", strings.Join(opts.PoolIDs, ","))
+
+	for name, value := range map[string]bool{
+		"humanDenoms":   opts.HumanDenoms,
+		"singleRoute":   opts.IsSingleRoute,
+		"appendBaseFee": opts.AppendBaseFee,
+	} {
+		if value {
+			key.Set(name, "true")
+		}
+	}
+
+	return key.Encode()
+}
+
+// normalizeToken normalizes the amount of a token, e.g. "0100uosmo", by removing
+// leading zeros and bucketing it to the configured significant digits.
+func (c *QuoteCache) normalizeToken(token string) string {
+	denomStart := strings.IndexFunc(token, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if denomStart == -1 {
+		denomStart = len(token)
+	}
+
+	amount, denom := strings.TrimLeft(token[:denomStart], "0"), token[denomStart:]
+
+	if digits := c.config.AmountSignificantDigits; digits > 0 && len(amount) > digits {
+		amount = amount[:digits] + strings.Repeat("0", len(amount)-digits)
+	}
+
+	return amount + denom
+}
+
+var _ SQSClient = &QuoteCache{}
@@ -0,0 +1,326 @@
+package sqsclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+	"github.com/osmosis-labs/sqs-go-client/sqsmock"
+)
+
+// newQuoteMock returns a mock returning quotes with the number of calls as amount out, after the given delay.
+func newQuoteMock(delay time.Duration) (*sqsmock.SQSMock, *atomic.Int32) {
+	var calls atomic.Int32
+	mock := &sqsmock.SQSMock{
+		GetQuoteFunc: func(ctx context.Context, options ...sqsclient.RouterQuoteOption) (sqsclient.SQSQuoteResponse, error) {
+			call := calls.Add(1)
+			time.Sleep(delay)
+			return sqsclient.SQSQuoteResponse{AmountOut: sqsclient.Coin{Amount: string(rune('0' + call))}}, nil
+		},
+	}
+	return mock, &calls
+}
+
+func TestQuoteCache_Key(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  []sqsclient.RouterQuoteOption
+		second []sqsclient.RouterQuoteOption
+		digits int
+		shared bool
+	}{
+		{
+			name:   "identical",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom)},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom)},
+			shared: true,
+		},
+		{
+			name:   "leading zeros",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn("1000000", uosmoDenom, atomDenom)},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn("01000000", uosmoDenom, atomDenom)},
+			shared: true,
+		},
+		{
+			name:   "denom order",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithInGivenOutCustom(1000000, atomDenom, []string{uosmoDenom, uionDenom})},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithInGivenOutCustom(1000000, atomDenom, []string{uionDenom, uosmoDenom})},
+		},
+		{
+			name:   "different amount",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom)},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1000001, uosmoDenom, atomDenom)},
+		},
+		{
+			name:   "bucketed amount",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1234567, uosmoDenom, atomDenom)},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1239999, uosmoDenom, atomDenom)},
+			digits: 3,
+			shared: true,
+		},
+		{
+			name:   "single route",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom)},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom), sqsclient.WithIsSingleRoute()},
+		},
+		{
+			name:   "custom route",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenInCustom(1000000, uosmoDenom, []string{atomDenom, usdcDenom}, []uint64{1, 2})},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenInCustom(1000000, uosmoDenom, []string{atomDenom, usdcDenom}, []uint64{1, 2})},
+			shared: true,
+		},
+		{
+			name:   "custom route order",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenInCustom(1000000, uosmoDenom, []string{atomDenom, usdcDenom}, []uint64{1, 2})},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenInCustom(1000000, uosmoDenom, []string{usdcDenom, atomDenom}, []uint64{2, 1})},
+		},
+		{
+			name:   "pool order",
+			first:  []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenInCustom(1000000, uosmoDenom, []string{atomDenom, usdcDenom}, []uint64{1, 2})},
+			second: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenInCustom(1000000, uosmoDenom, []string{atomDenom, usdcDenom}, []uint64{2, 1})},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, calls := newQuoteMock(0)
+			cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{TTL: time.Minute, AmountSignificantDigits: tc.digits})
+			require.NoError(t, err)
+
+			_, err = cache.GetQuote(context.Background(), tc.first...)
+			require.NoError(t, err)
+			_, err = cache.GetQuote(context.Background(), tc.second...)
+			require.NoError(t, err)
+
+			if tc.shared {
+				require.Equal(t, int32(1), calls.Load())
+				require.Equal(t, uint64(1), cache.Stats().Hits())
+			} else {
+				require.Equal(t, int32(2), calls.Load())
+			}
+		})
+	}
+}
+
+func TestQuoteCache_TTL(t *testing.T) {
+	mock, calls := newQuoteMock(0)
+	cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{TTL: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = cache.GetQuote(ctx, sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom))
+	require.NoError(t, err)
+
+	time.Sleep(20 * time.Millisecond)
+
+	quote, err := cache.GetQuote(ctx, sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom))
+	require.NoError(t, err)
+	require.Equal(t, "2", quote.AmountOut.Amount)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestQuoteCache_Bypass(t *testing.T) {
+	mock, calls := newQuoteMock(0)
+	cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	option := sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom)
+
+	_, err = cache.GetQuote(ctx, option)
+	require.NoError(t, err)
+
+	quote, err := cache.GetQuote(ctx, option, sqsclient.WithBypassQuoteCache())
+	require.NoError(t, err)
+	require.Equal(t, "2", quote.AmountOut.Amount)
+
+	quote, err = cache.GetQuote(sqsclient.ContextWithoutQuoteCache(ctx), option)
+	require.NoError(t, err)
+	require.Equal(t, "3", quote.AmountOut.Amount)
+
+	// The fresh quote was cached.
+	quote, err = cache.GetQuote(ctx, option)
+	require.NoError(t, err)
+	require.Equal(t, "3", quote.AmountOut.Amount)
+	require.Equal(t, int32(3), calls.Load())
+
+	cache.Invalidate()
+
+	_, err = cache.GetQuote(ctx, option)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), calls.Load())
+}
+
+func TestQuoteCache_InvalidateDuringFetch(t *testing.T) {
+	mock, calls := newQuoteMock(0)
+	getQuote := mock.GetQuoteFunc
+	mock.GetQuoteFunc = func(ctx context.Context, options ...sqsclient.RouterQuoteOption) (sqsclient.SQSQuoteResponse, error) {
+		quote, err := getQuote(ctx, options...)
+		// The fetch started before Invalidate completes last.
+		if quote.AmountOut.Amount == "1" {
+			time.Sleep(50 * time.Millisecond)
+		}
+		return quote, err
+	}
+
+	cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	option := sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = cache.GetQuote(ctx, option)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cache.Invalidate()
+
+	// Callers after Invalidate do not get the quote fetched before.
+	quote, err := cache.GetQuote(ctx, option)
+	require.NoError(t, err)
+	require.Equal(t, "2", quote.AmountOut.Amount)
+
+	// Nor is it cached once fetched.
+	<-done
+	quote, err = cache.GetQuote(ctx, option)
+	require.NoError(t, err)
+	require.Equal(t, "2", quote.AmountOut.Amount)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestQuoteCache_Deduplicates(t *testing.T) {
+	mock, calls := newQuoteMock(50 * time.Millisecond)
+	cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cache.GetQuote(context.Background(), sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom))
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestQuoteCache_MaxEntries(t *testing.T) {
+	mock, calls := newQuoteMock(0)
+	cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{TTL: time.Minute, MaxEntries: 2})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, amount := range []int{1, 2, 3} {
+		_, err = cache.GetQuote(ctx, sqsclient.WithOutGivenIn(amount, uosmoDenom, atomDenom))
+		require.NoError(t, err)
+	}
+
+	// The latest quote is cached.
+	_, err = cache.GetQuote(ctx, sqsclient.WithOutGivenIn(3, uosmoDenom, atomDenom))
+	require.NoError(t, err)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestQuoteCache_Errors(t *testing.T) {
+	errSQS := errors.New("sqs is down")
+	mock := &sqsmock.SQSMock{Err: errSQS}
+
+	cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{})
+	require.NoError(t, err)
+
+	_, err = cache.GetQuote(context.Background(), sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom))
+	require.ErrorIs(t, err, errSQS)
+	require.Equal(t, uint64(0), cache.Stats().Hits())
+
+	_, err = sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{AmountSignificantDigits: -1})
+	require.Error(t, err)
+}
+
+func TestQuoteCache_DenomOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"amount_in":"1000","amount_out":{"denom":"` + atomDenom + `","amount":"1000000"}}`))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	cache, err := sqsclient.NewQuoteCache(sqs, sqsclient.QuoteCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// The quote is labelled with the first denom of each caller.
+	quote, err := cache.GetQuote(ctx, sqsclient.WithInGivenOutCustom(1000000, atomDenom, []string{uosmoDenom, uionDenom}))
+	require.NoError(t, err)
+	require.Equal(t, uosmoDenom, quote.AmountIn.Denom)
+
+	quote, err = cache.GetQuote(ctx, sqsclient.WithInGivenOutCustom(1000000, atomDenom, []string{uionDenom, uosmoDenom}))
+	require.NoError(t, err)
+	require.Equal(t, uionDenom, quote.AmountIn.Denom)
+}
+
+func TestQuoteCache_WaitersKeepTheirContext(t *testing.T) {
+	mock, calls := newQuoteMock(50 * time.Millisecond)
+	getQuote := mock.GetQuoteFunc
+	mock.GetQuoteFunc = func(ctx context.Context, options ...sqsclient.RouterQuoteOption) (sqsclient.SQSQuoteResponse, error) {
+		quote, err := getQuote(ctx, options...)
+		if ctx.Err() != nil {
+			return sqsclient.SQSQuoteResponse{}, ctx.Err()
+		}
+		return quote, err
+	}
+
+	cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	option := sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom)
+
+	// The first caller cancels before the quote is fetched.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errs := make(chan error)
+	go func() {
+		_, err := cache.GetQuote(ctx, option)
+		errs <- err
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+
+	// The second caller shares the request, which is not cancelled with the first caller.
+	quotes := make(chan sqsclient.SQSQuoteResponse)
+	go func() {
+		quote, err := cache.GetQuote(context.Background(), option)
+		require.NoError(t, err)
+		quotes <- quote
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+
+	// The first caller returns right away.
+	select {
+	case err := <-errs:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(20 * time.Millisecond):
+		t.Fatal("the cancelled caller waited for the shared request")
+	}
+
+	require.Equal(t, "1", (<-quotes).AmountOut.Amount)
+	require.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,102 @@
+package sqsclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the number of concurrent requests of a batch when BatchOptions.Concurrency is zero.
+const DefaultBatchConcurrency = 8
+
+// QuoteRequest is a quote to get with GetQuotes.
+type QuoteRequest struct {
+	// Options are the options of the quote, as given to GetQuote.
+	Options []RouterQuoteOption
+}
+
+// QuoteResult is the result of a QuoteRequest.
+type QuoteResult struct {
+	Quote SQSQuoteResponse
+	// Err is the error getting the quote, if any.
+	Err error
+}
+
+// BatchOptions are the options of a batch of requests.
+type BatchOptions struct {
+	// Concurrency is the maximum number of concurrent requests.
+	// If zero, DefaultBatchConcurrency is used.
+	Concurrency int
+}
+
+// BatchOption is the type for the options of a batch of requests.
+type BatchOption func(opts *BatchOptions)
+
+// WithConcurrency is an option to set the maximum number of concurrent requests of a batch.
+func WithConcurrency(concurrency int) BatchOption {
+	return func(opts *BatchOptions) {
+		opts.Concurrency = concurrency
+	}
+}
+
+// Validate validates the BatchOptions.
+func (opts *BatchOptions) Validate() error {
+	if opts.Concurrency < 0 {
+		return fmt.Errorf("concurrency cannot be negative")
+	}
+
+	return nil
+}
+
+// GetQuotes implements SQSClient
+func (o *sqs) GetQuotes(ctx context.Context, requests []QuoteRequest, options ...BatchOption) ([]QuoteResult, error) {
+	return getQuotes(ctx, o.GetQuote, requests, options...)
+}
+
+// getQuotes gets the quotes of the requests with getQuote, with at most the configured
+// number of concurrent calls. The results are in the order of the requests.
+// Requests not started when the context is done fail with the context error.
+func getQuotes(
+	ctx context.Context,
+	getQuote func(ctx context.Context, options ...RouterQuoteOption) (SQSQuoteResponse, error),
+	requests []QuoteRequest,
+	options ...BatchOption,
+) ([]QuoteResult, error) {
+	opts := BatchOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOptions, err)
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	results := make([]QuoteResult, len(requests))
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, request := range requests {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			results[i].Quote, results[i].Err = getQuote(ctx, request.Options...)
+		}()
+	}
+	wg.Wait()
+
+	return results, nil
+}
@@ -0,0 +1,142 @@
+package sqsclient_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+// newQuotesServer returns a server answering quotes with the amount in as amount out after the
+// given delay, failing for amounts starting with 9, and recording the peak of concurrent requests.
+func newQuotesServer(t *testing.T, delay time.Duration) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(delay)
+
+		amount := strings.TrimSuffix(r.URL.Query().Get("tokenIn"), uosmoDenom)
+		if strings.HasPrefix(amount, "9") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"amount too large"}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"amount_in":{"denom":"uosmo","amount":"%s"},"amount_out":"%s"}`, amount, amount)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &peak
+}
+
+func TestGetQuotes(t *testing.T) {
+	server, peak := newQuotesServer(t, 20*time.Millisecond)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	amounts := []int{1, 2, 9, 4, 5, 6, 7, 8}
+	requests := make([]sqsclient.QuoteRequest, len(amounts))
+	for i, amount := range amounts {
+		requests[i] = sqsclient.QuoteRequest{Options: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(amount, uosmoDenom, atomDenom)}}
+	}
+
+	results, err := sqs.GetQuotes(context.Background(), requests, sqsclient.WithConcurrency(3))
+	require.NoError(t, err)
+	require.Len(t, results, len(amounts))
+
+	for i, amount := range amounts {
+		if amount == 9 {
+			require.ErrorIs(t, results[i].Err, sqsclient.ErrBadRequest)
+			continue
+		}
+		require.NoError(t, results[i].Err)
+		require.Equal(t, fmt.Sprint(amount), results[i].Quote.AmountOut.Amount)
+	}
+
+	require.LessOrEqual(t, peak.Load(), int32(3))
+	require.Greater(t, peak.Load(), int32(1))
+}
+
+func TestGetQuotes_RateLimited(t *testing.T) {
+	server, _ := newQuotesServer(t, 0)
+
+	rateLimiter, err := sqsclient.NewRateLimiter(&sqsclient.RateLimit{RequestsPerSecond: 50, Burst: 1}, nil)
+	require.NoError(t, err)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithRateLimiterOpt(rateLimiter))
+	require.NoError(t, err)
+
+	requests := make([]sqsclient.QuoteRequest, 6)
+	for i := range requests {
+		requests[i] = sqsclient.QuoteRequest{Options: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(i+1, uosmoDenom, atomDenom)}}
+	}
+
+	start := time.Now()
+	results, err := sqs.GetQuotes(context.Background(), requests, sqsclient.WithConcurrency(6))
+	require.NoError(t, err)
+
+	// 5 requests wait 20ms each for a token.
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+	}
+}
+
+func TestGetQuotes_ContextCancelled(t *testing.T) {
+	server, _ := newQuotesServer(t, 50*time.Millisecond)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	requests := make([]sqsclient.QuoteRequest, 4)
+	for i := range requests {
+		requests[i] = sqsclient.QuoteRequest{Options: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(i+1, uosmoDenom, atomDenom)}}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	results, err := sqs.GetQuotes(ctx, requests, sqsclient.WithConcurrency(1))
+	require.NoError(t, err)
+	for _, result := range results {
+		require.Error(t, result.Err)
+	}
+
+	_, err = sqs.GetQuotes(context.Background(), requests, sqsclient.WithConcurrency(-1))
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
+
+func TestQuoteCache_GetQuotes(t *testing.T) {
+	mock, calls := newQuoteMock(0)
+	cache, err := sqsclient.NewQuoteCache(mock, sqsclient.QuoteCacheConfig{TTL: time.Minute})
+	require.NoError(t, err)
+
+	request := sqsclient.QuoteRequest{Options: []sqsclient.RouterQuoteOption{sqsclient.WithOutGivenIn(1000000, uosmoDenom, atomDenom)}}
+
+	results, err := cache.GetQuotes(context.Background(), []sqsclient.QuoteRequest{request, request, request}, sqsclient.WithConcurrency(1))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		require.Equal(t, "1", result.Quote.AmountOut.Amount)
+	}
+	require.Equal(t, int32(1), calls.Load())
+}
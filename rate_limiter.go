@@ -0,0 +1,215 @@
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimit is the budget of a token bucket.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket refills.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests that can be made at once.
+	// If zero, it defaults to 1.
+	Burst int
+}
+
+// Validate validates the RateLimit.
+func (l RateLimit) Validate() error {
+	if l.RequestsPerSecond <= 0 {
+		return errors.New("rate limit requests per second must be positive")
+	}
+
+	if l.Burst < 0 {
+		return errors.New("rate limit burst cannot be negative")
+	}
+
+	return nil
+}
+
+// RateLimiterStats is a snapshot of the state of the rate limiter for an endpoint.
+type RateLimiterStats struct {
+	// Waiting is the number of requests currently blocked waiting for a slot.
+	Waiting int
+	// Available is the number of requests that can be made without waiting.
+	Available float64
+	// EstimatedWait is how long a new request would wait for a slot.
+	EstimatedWait time.Duration
+}
+
+// RateLimiter is a client-side token bucket rate limiter with per-endpoint budgets.
+// Requests to an endpoint without its own budget share the default budget.
+// It is safe for concurrent use.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	defaultLimit   *RateLimit
+	endpointLimits map[string]RateLimit
+	buckets        map[string]*tokenBucket
+}
+
+// NewRateLimiter creates a new RateLimiter.
+// defaultLimit applies to every endpoint without an entry in endpointLimits, such as
+// "router/quote", "tokens/prices" or "tokens/metadata". If defaultLimit is nil,
+// such endpoints are not rate limited.
+func NewRateLimiter(defaultLimit *RateLimit, endpointLimits map[string]RateLimit) (*RateLimiter, error) {
+	if defaultLimit != nil {
+		if err := defaultLimit.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	for endpoint, limit := range endpointLimits {
+		if err := limit.Validate(); err != nil {
+			return nil, fmt.Errorf("endpoint %s: %w", endpoint, err)
+		}
+	}
+
+	return &RateLimiter{
+		defaultLimit:   defaultLimit,
+		endpointLimits: endpointLimits,
+		buckets:        make(map[string]*tokenBucket),
+	}, nil
+}
+
+// Wait blocks until a request to the given endpoint is allowed or the context is done.
+// It returns immediately with an error if the wait would exceed the context deadline.
+func (l *RateLimiter) Wait(ctx context.Context, endpoint string) error {
+	if l == nil {
+		return nil
+	}
+
+	bucket := l.bucket(endpoint)
+	if bucket == nil {
+		return nil
+	}
+
+	return bucket.wait(ctx)
+}
+
+// Stats returns a snapshot of the state of the rate limiter for the given endpoint.
+func (l *RateLimiter) Stats(endpoint string) RateLimiterStats {
+	if l == nil {
+		return RateLimiterStats{Available: math.Inf(1)}
+	}
+
+	bucket := l.bucket(endpoint)
+	if bucket == nil {
+		return RateLimiterStats{Available: math.Inf(1)}
+	}
+
+	return bucket.stats()
+}
+
+// bucket returns the token bucket for the given endpoint, or nil if the endpoint is not rate limited.
+// Endpoints without their own budget share the default bucket.
+func (l *RateLimiter) bucket(endpoint string) *tokenBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit, ok := l.endpointLimits[endpoint]
+	if !ok {
+		if l.defaultLimit == nil {
+			return nil
+		}
+		limit = *l.defaultLimit
+		endpoint = ""
+	}
+
+	bucket, ok := l.buckets[endpoint]
+	if !ok {
+		bucket = newTokenBucket(limit)
+		l.buckets[endpoint] = bucket
+	}
+
+	return bucket
+}
+
+// tokenBucket is a token bucket where waiters reserve tokens ahead of time,
+// letting the token count go negative while they wait.
+type tokenBucket struct {
+	mu sync.Mutex
+
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	waiting int
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := float64(max(limit.Burst, 1))
+	return &tokenBucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// advance refills the bucket up to now. The caller must hold the lock.
+func (b *tokenBucket) advance(now time.Time) {
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	b.advance(time.Now())
+
+	b.tokens--
+	if b.tokens >= 0 {
+		b.mu.Unlock()
+		return nil
+	}
+
+	wait := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		b.tokens++
+		b.mu.Unlock()
+		return fmt.Errorf("rate limiter wait of %s exceeds context deadline: %w", wait, context.DeadlineExceeded)
+	}
+
+	b.waiting++
+	b.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		b.mu.Lock()
+		b.waiting--
+		b.mu.Unlock()
+		return nil
+	case <-ctx.Done():
+		// Give the reserved token back to the callers queued behind.
+		b.mu.Lock()
+		b.waiting--
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (b *tokenBucket) stats() RateLimiterStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(time.Now())
+
+	var estimatedWait time.Duration
+	if b.tokens < 1 {
+		estimatedWait = time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	}
+
+	return RateLimiterStats{
+		Waiting:       b.waiting,
+		Available:     math.Max(b.tokens, 0),
+		EstimatedWait: estimatedWait,
+	}
+}
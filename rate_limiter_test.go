@@ -0,0 +1,119 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter, err := sqsclient.NewRateLimiter(nil, map[string]sqsclient.RateLimit{
+		sqsclient.RouterQuoteEndpoint: {RequestsPerSecond: 20, Burst: 1},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.Wait(ctx, sqsclient.RouterQuoteEndpoint))
+	}
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// Endpoints without a budget are not rate limited when there is no default.
+	start = time.Now()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, limiter.Wait(ctx, sqsclient.TokensPricesEndpoint))
+	}
+	require.Less(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestRateLimiter_DefaultLimit(t *testing.T) {
+	limiter, err := sqsclient.NewRateLimiter(&sqsclient.RateLimit{RequestsPerSecond: 1, Burst: 2}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// The default budget is shared by all endpoints.
+	require.NoError(t, limiter.Wait(ctx, sqsclient.TokensPricesEndpoint))
+	require.NoError(t, limiter.Wait(ctx, sqsclient.TokensMetadataEndpoint))
+
+	stats := limiter.Stats(sqsclient.RouterQuoteEndpoint)
+	require.Less(t, stats.Available, 1.0)
+	require.Greater(t, stats.EstimatedWait, time.Duration(0))
+}
+
+func TestRateLimiter_ContextDeadline(t *testing.T) {
+	limiter, err := sqsclient.NewRateLimiter(&sqsclient.RateLimit{RequestsPerSecond: 0.1, Burst: 1}, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, limiter.Wait(context.Background(), sqsclient.RouterQuoteEndpoint))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = limiter.Wait(ctx, sqsclient.RouterQuoteEndpoint)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The failed wait does not hold on to its reservation.
+	require.Equal(t, 0, limiter.Stats(sqsclient.RouterQuoteEndpoint).Waiting)
+}
+
+func TestRateLimiter_Stats(t *testing.T) {
+	limiter, err := sqsclient.NewRateLimiter(&sqsclient.RateLimit{RequestsPerSecond: 10, Burst: 1}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, limiter.Wait(ctx, sqsclient.RouterQuoteEndpoint))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = limiter.Wait(ctx, sqsclient.RouterQuoteEndpoint)
+	}()
+
+	require.Eventually(t, func() bool {
+		return limiter.Stats(sqsclient.RouterQuoteEndpoint).Waiting == 1
+	}, time.Second, time.Millisecond)
+
+	<-done
+	require.Equal(t, 0, limiter.Stats(sqsclient.RouterQuoteEndpoint).Waiting)
+}
+
+func TestRateLimiter_Client(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	limiter, err := sqsclient.NewRateLimiter(nil, map[string]sqsclient.RateLimit{
+		sqsclient.TokensMetadataEndpoint: {RequestsPerSecond: 20, Burst: 1},
+	})
+	require.NoError(t, err)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithRateLimiterOpt(limiter))
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := sqs.GetTokensMetadata(context.Background())
+		require.NoError(t, err)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestNewRateLimiter_Invalid(t *testing.T) {
+	_, err := sqsclient.NewRateLimiter(&sqsclient.RateLimit{RequestsPerSecond: 0}, nil)
+	require.Error(t, err)
+
+	_, err = sqsclient.NewRateLimiter(nil, map[string]sqsclient.RateLimit{
+		sqsclient.RouterQuoteEndpoint: {RequestsPerSecond: 1, Burst: -1},
+	})
+	require.Error(t, err)
+}
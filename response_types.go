@@ -1,5 +1,12 @@
 package sqsclient
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
 type OsmosisTokenMetadata struct {
 	Name             string `json:"name"`
 	Symbol           string `json:"symbol"`
@@ -52,3 +59,277 @@ type Coin struct {
 	Denom  string `json:"denom"`
 	Amount string `json:"amount"`
 }
+
+// PoolsResponse is the response of the /pools endpoint.
+type PoolsResponse struct {
+	Data []PoolModel    `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It also accepts the plain list of pools returned by SQS versions without pagination.
+func (r *PoolsResponse) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		r.Meta = PaginationMeta{}
+		return json.Unmarshal(data, &r.Data)
+	}
+
+	type poolsResponse PoolsResponse
+	return json.Unmarshal(data, (*poolsResponse)(r))
+}
+
+// PaginationMeta is the pagination metadata returned by paginated endpoints.
+type PaginationMeta struct {
+	TotalItems uint64 `json:"total_items"`
+	NextCursor int64  `json:"next_cursor"`
+}
+
+// PoolModel is a pool as returned by the /pools endpoint.
+type PoolModel struct {
+	// ChainModel is the pool as stored on chain. Its shape depends on the pool type.
+	ChainModel        json.RawMessage `json:"chain_model"`
+	Balances          []Coin          `json:"balances"`
+	Type              PoolType        `json:"type"`
+	SpreadFactor      string          `json:"spread_factor"`
+	TakerFee          string          `json:"taker_fee,omitempty"`
+	LiquidityCap      string          `json:"liquidity_cap"`
+	LiquidityCapError string          `json:"liquidity_cap_error,omitempty"`
+	APRData           *PoolAPRData    `json:"apr_data,omitempty"`
+	FeesData          *PoolFeesData   `json:"fees_data,omitempty"`
+}
+
+// ID returns the ID of the pool, read from its chain model.
+func (p *PoolModel) ID() (uint64, error) {
+	var chainModel struct {
+		ID     json.Number `json:"id"`
+		PoolID json.Number `json:"pool_id"`
+	}
+	if err := json.Unmarshal(p.ChainModel, &chainModel); err != nil {
+		return 0, fmt.Errorf("error parsing pool chain model: %w", err)
+	}
+
+	id := chainModel.ID
+	if id == "" {
+		id = chainModel.PoolID
+	}
+
+	return strconv.ParseUint(id.String(), 10, 64)
+}
+
+// PoolAPRData is the APR breakdown of a pool, as percentages.
+type PoolAPRData struct {
+	SwapFees   APRRange `json:"swap_fees"`
+	Superfluid APRRange `json:"superfluid"`
+	Osmosis    APRRange `json:"osmosis"`
+	Boost      APRRange `json:"boost"`
+	Total      APRRange `json:"total"`
+}
+
+// APRRange is the range of APR, as percentages, a position in a pool can earn.
+type APRRange struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+}
+
+// PoolFeesData is the fee and volume data of a pool.
+type PoolFeesData struct {
+	Volume24h      float64 `json:"volume_24h"`
+	Volume7d       float64 `json:"volume_7d"`
+	FeesSpent24h   float64 `json:"fees_spent_24h"`
+	FeesSpent7d    float64 `json:"fees_spent_7d"`
+	FeesPercentage string  `json:"fees_percentage"`
+}
+
+// CandidateRoutes are the routes the router considers for a token pair,
+// as returned by the /router/routes and /router/cached-routes endpoints.
+type CandidateRoutes struct {
+	Routes                     []CandidateRoute `json:"Routes"`
+	ContainsCanonicalOrderbook bool             `json:"ContainsCanonicalOrderbook"`
+	// Cached is whether the routes were read from the router's route cache.
+	Cached bool `json:"-"`
+}
+
+// PoolIDs returns the IDs of the pools used by the candidate routes,
+// without duplicates and in the order they first appear.
+func (r *CandidateRoutes) PoolIDs() []uint64 {
+	seen := make(map[uint64]struct{})
+	poolIDs := make([]uint64, 0)
+	for _, route := range r.Routes {
+		for _, pool := range route.Pools {
+			if _, ok := seen[pool.ID]; ok {
+				continue
+			}
+			seen[pool.ID] = struct{}{}
+			poolIDs = append(poolIDs, pool.ID)
+		}
+	}
+	return poolIDs
+}
+
+// CandidateRoute is a route the router considers, as a list of hops.
+type CandidateRoute struct {
+	// IsCanonicalOrderbookRoute is whether the route is through the canonical orderbook of the pair.
+	IsCanonicalOrderbookRoute bool            `json:"IsCanonicalOrderboolRoute"`
+	Pools                     []CandidatePool `json:"Pools"`
+}
+
+// CandidatePool is a hop of a candidate route.
+type CandidatePool struct {
+	ID uint64 `json:"ID"`
+	// Type is the pool type. It is only set by SQS versions that report it.
+	Type          PoolType `json:"Type"`
+	TokenInDenom  string   `json:"TokenInDenom,omitempty"`
+	TokenOutDenom string   `json:"TokenOutDenom"`
+}
+
+// PoolTicks is the liquidity of a concentrated liquidity pool per tick range,
+// as returned by the /pools/ticks/{id} endpoint, along with the current state of the pool.
+type PoolTicks struct {
+	Ticks            []TickRange `json:"ticks"`
+	CurrentTickIndex int64       `json:"current_tick_index"`
+	HasNoLiquidity   bool        `json:"has_no_liquidity"`
+
+	// The fields below are read from the pool model.
+	CurrentTick      int64  `json:"-"`
+	CurrentSqrtPrice Dec    `json:"-"`
+	Token0           string `json:"-"`
+	Token1           string `json:"-"`
+}
+
+// TickRange is the liquidity between two ticks of a concentrated liquidity pool.
+type TickRange struct {
+	LowerTick       int64 `json:"lower_tick"`
+	UpperTick       int64 `json:"upper_tick"`
+	LiquidityAmount Dec   `json:"liquidity_amount"`
+}
+
+// TickPriceRange is a TickRange converted to prices of token0 in terms of token1,
+// adjusted for the decimals of the tokens.
+type TickPriceRange struct {
+	LowerPrice      Dec
+	UpperPrice      Dec
+	LiquidityAmount Dec
+}
+
+// OrderDirection is the direction of an orderbook limit order.
+type OrderDirection string
+
+const (
+	OrderDirectionBid OrderDirection = "bid"
+	OrderDirectionAsk OrderDirection = "ask"
+)
+
+// OrderStatus is the status of an orderbook limit order.
+type OrderStatus string
+
+const (
+	OrderStatusOpen            OrderStatus = "open"
+	OrderStatusPartiallyFilled OrderStatus = "partiallyFilled"
+	OrderStatusFilled          OrderStatus = "filled"
+	OrderStatusFullyClaimed    OrderStatus = "fullyClaimed"
+	OrderStatusCancelled       OrderStatus = "cancelled"
+)
+
+// LimitOrders are the orderbook limit orders of an address, as returned by the
+// /pools/all-orders and /pools/order-history endpoints.
+type LimitOrders struct {
+	Orders []LimitOrder `json:"orders"`
+	// IsBestEffort is whether some orders could not be retrieved.
+	IsBestEffort bool `json:"is_best_effort"`
+}
+
+// LimitOrder is an orderbook limit order.
+type LimitOrder struct {
+	OrderID          int64          `json:"order_id"`
+	TickID           int64          `json:"tick_id"`
+	PoolID           uint64         `json:"pool_id,omitempty"`
+	OrderbookAddress string         `json:"orderbookAddress"`
+	Owner            string         `json:"owner"`
+	OrderDirection   OrderDirection `json:"order_direction"`
+	Status           OrderStatus    `json:"status"`
+	Price            Dec            `json:"price"`
+	// Quantity is the amount remaining in the order.
+	Quantity Dec `json:"quantity"`
+	// PlacedQuantity is the amount the order was placed with.
+	PlacedQuantity Dec `json:"placed_quantity"`
+	// PlacedAt is the Unix time the order was placed at.
+	PlacedAt       int64      `json:"placed_at"`
+	TotalFilled    Dec        `json:"totalFilled"`
+	PercentFilled  Dec        `json:"percentFilled"`
+	PercentClaimed Dec        `json:"percentClaimed"`
+	Output         Dec        `json:"output"`
+	BaseAsset      OrderAsset `json:"base_asset"`
+	QuoteAsset     OrderAsset `json:"quote_asset"`
+}
+
+// OrderAsset is an asset traded by an orderbook limit order.
+type OrderAsset struct {
+	Symbol   string `json:"symbol"`
+	Decimals int    `json:"decimals"`
+}
+
+// PortfolioCategory is a category of assets in a portfolio.
+type PortfolioCategory string
+
+const (
+	PortfolioCategoryUserBalances     PortfolioCategory = "user-balances"
+	PortfolioCategoryStaked           PortfolioCategory = "staked"
+	PortfolioCategoryUnstaking        PortfolioCategory = "unstaking"
+	PortfolioCategoryPooled           PortfolioCategory = "pooled"
+	PortfolioCategoryInLocks          PortfolioCategory = "in-locks"
+	PortfolioCategoryUnclaimedRewards PortfolioCategory = "unclaimed-rewards"
+	PortfolioCategoryTotalAssets      PortfolioCategory = "total-assets"
+)
+
+// PortfolioAssets is the breakdown of the assets of an address per category,
+// as returned by the /passthrough/portfolio-assets/{address} endpoint.
+type PortfolioAssets struct {
+	Categories map[PortfolioCategory]PortfolioCategoryAssets `json:"categories"`
+}
+
+// Bank returns the assets held in the bank module, i.e. the user balances.
+func (p PortfolioAssets) Bank() PortfolioCategoryAssets {
+	return p.Categories[PortfolioCategoryUserBalances]
+}
+
+// Staked returns the assets delegated to validators.
+func (p PortfolioAssets) Staked() PortfolioCategoryAssets {
+	return p.Categories[PortfolioCategoryStaked]
+}
+
+// Unstaking returns the assets being undelegated.
+func (p PortfolioAssets) Unstaking() PortfolioCategoryAssets {
+	return p.Categories[PortfolioCategoryUnstaking]
+}
+
+// Pooled returns the assets provided as liquidity to pools.
+func (p PortfolioAssets) Pooled() PortfolioCategoryAssets {
+	return p.Categories[PortfolioCategoryPooled]
+}
+
+// InLocks returns the assets in lockups.
+func (p PortfolioAssets) InLocks() PortfolioCategoryAssets {
+	return p.Categories[PortfolioCategoryInLocks]
+}
+
+// Total returns the total assets across all categories.
+func (p PortfolioAssets) Total() PortfolioCategoryAssets {
+	return p.Categories[PortfolioCategoryTotalAssets]
+}
+
+// PortfolioCategoryAssets are the assets of a portfolio category.
+type PortfolioCategoryAssets struct {
+	// Capitalization is the value of the assets in the category, in USD.
+	Capitalization Dec `json:"capitalization"`
+	// AccountCoins are the coins in the category with their value, in USD.
+	AccountCoins []PortfolioCoin `json:"account_coins_result"`
+	// IsBestEffort is whether some assets could not be retrieved or valued.
+	IsBestEffort bool `json:"is_best_effort"`
+}
+
+// PortfolioCoin is a coin in a portfolio with its value.
+type PortfolioCoin struct {
+	Coin Coin `json:"coin"`
+	// CapValue is the value of the coin, in USD.
+	CapValue Dec `json:"cap_value"`
+}
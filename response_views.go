@@ -0,0 +1,217 @@
+package sqsclient
+
+import "fmt"
+
+// TypedCoin is a Coin with its amount parsed.
+type TypedCoin struct {
+	Denom  string
+	Amount Int
+}
+
+// TypedQuote is a view of SQSQuoteResponse with amounts, fees and prices parsed.
+type TypedQuote struct {
+	AmountIn                TypedCoin
+	AmountOut               TypedCoin
+	Route                   []TypedRoute
+	EffectiveFee            Dec
+	PriceImpact             Dec
+	InBaseOutQuoteSpotPrice Dec
+	PriceInfo               TypedPriceInfo
+}
+
+// TypedRoute is a view of Route with amounts parsed.
+type TypedRoute struct {
+	Pools                      []TypedPool
+	HasGeneralizedCosmWasmPool bool
+	OutAmount                  Int
+	InAmount                   Int
+}
+
+// TypedPool is a view of Pool with balances and fees parsed.
+type TypedPool struct {
+	ID            uint64
+	Type          int32
+	Balances      []TypedCoin
+	SpreadFactor  Dec
+	TokenOutDenom string
+	TokenInDenom  string
+	TakerFee      Dec
+	CodeID        uint64
+}
+
+// TypedPriceInfo is a view of PriceInfo with the fees parsed.
+type TypedPriceInfo struct {
+	AdjustedGasUsed uint64
+	FeeCoin         TypedCoin
+	BaseFee         Dec
+	Err             string
+}
+
+// Typed parses the amount of the coin. An empty amount is 0.
+func (c Coin) Typed() (TypedCoin, error) {
+	amount, err := parseOptionalInt(c.Amount)
+	if err != nil {
+		return TypedCoin{}, fmt.Errorf("invalid amount of %s: %w", c.Denom, err)
+	}
+
+	return TypedCoin{Denom: c.Denom, Amount: amount}, nil
+}
+
+// Typed parses the amounts, fees and prices of the quote. Empty values are 0.
+func (q SQSQuoteResponse) Typed() (TypedQuote, error) {
+	var (
+		typed TypedQuote
+		err   error
+	)
+
+	if typed.AmountIn, err = q.AmountIn.Typed(); err != nil {
+		return TypedQuote{}, fmt.Errorf("invalid amount in: %w", err)
+	}
+
+	if typed.AmountOut, err = q.AmountOut.Typed(); err != nil {
+		return TypedQuote{}, fmt.Errorf("invalid amount out: %w", err)
+	}
+
+	if typed.EffectiveFee, err = parseOptionalDec(q.EffectiveFee); err != nil {
+		return TypedQuote{}, fmt.Errorf("invalid effective fee: %w", err)
+	}
+
+	if typed.PriceImpact, err = parseOptionalDec(q.PriceImpact); err != nil {
+		return TypedQuote{}, fmt.Errorf("invalid price impact: %w", err)
+	}
+
+	if typed.InBaseOutQuoteSpotPrice, err = parseOptionalDec(q.InBaseOutQuoteSpotPrice); err != nil {
+		return TypedQuote{}, fmt.Errorf("invalid spot price: %w", err)
+	}
+
+	if typed.PriceInfo, err = q.PriceInfo.Typed(); err != nil {
+		return TypedQuote{}, err
+	}
+
+	typed.Route = make([]TypedRoute, 0, len(q.Route))
+	for i, route := range q.Route {
+		typedRoute, err := route.Typed()
+		if err != nil {
+			return TypedQuote{}, fmt.Errorf("invalid route %d: %w", i, err)
+		}
+		typed.Route = append(typed.Route, typedRoute)
+	}
+
+	return typed, nil
+}
+
+// Typed parses the amounts, balances and fees of the route. Empty values are 0.
+func (r Route) Typed() (TypedRoute, error) {
+	outAmount, err := parseOptionalInt(r.OutAmount)
+	if err != nil {
+		return TypedRoute{}, fmt.Errorf("invalid out amount: %w", err)
+	}
+
+	inAmount, err := parseOptionalInt(r.InAmount)
+	if err != nil {
+		return TypedRoute{}, fmt.Errorf("invalid in amount: %w", err)
+	}
+
+	pools := make([]TypedPool, 0, len(r.Pools))
+	for _, pool := range r.Pools {
+		typedPool, err := pool.Typed()
+		if err != nil {
+			return TypedRoute{}, err
+		}
+		pools = append(pools, typedPool)
+	}
+
+	return TypedRoute{
+		Pools:                      pools,
+		HasGeneralizedCosmWasmPool: r.HasGeneralizedCosmWasmPool,
+		OutAmount:                  outAmount,
+		InAmount:                   inAmount,
+	}, nil
+}
+
+// Typed parses the balances and fees of the pool. Empty values are 0.
+func (p Pool) Typed() (TypedPool, error) {
+	spreadFactor, err := parseOptionalDec(p.SpreadFactor)
+	if err != nil {
+		return TypedPool{}, fmt.Errorf("invalid spread factor of pool %d: %w", p.ID, err)
+	}
+
+	takerFee, err := parseOptionalDec(p.TakerFee)
+	if err != nil {
+		return TypedPool{}, fmt.Errorf("invalid taker fee of pool %d: %w", p.ID, err)
+	}
+
+	balances := make([]TypedCoin, 0, len(p.Balances))
+	for _, balance := range p.Balances {
+		typedBalance, err := balance.Typed()
+		if err != nil {
+			return TypedPool{}, fmt.Errorf("invalid balance of pool %d: %w", p.ID, err)
+		}
+		balances = append(balances, typedBalance)
+	}
+
+	return TypedPool{
+		ID:            p.ID,
+		Type:          p.Type,
+		Balances:      balances,
+		SpreadFactor:  spreadFactor,
+		TokenOutDenom: p.TokenOutDenom,
+		TokenInDenom:  p.TokenInDenom,
+		TakerFee:      takerFee,
+		CodeID:        p.CodeID,
+	}, nil
+}
+
+// Typed parses the fees of the price info. Empty values are 0.
+func (p PriceInfo) Typed() (TypedPriceInfo, error) {
+	feeCoin, err := p.FeeCoin.Typed()
+	if err != nil {
+		return TypedPriceInfo{}, fmt.Errorf("invalid fee coin: %w", err)
+	}
+
+	baseFee, err := parseOptionalDec(p.BaseFee)
+	if err != nil {
+		return TypedPriceInfo{}, fmt.Errorf("invalid base fee: %w", err)
+	}
+
+	return TypedPriceInfo{
+		AdjustedGasUsed: p.AdjustedGasUsed,
+		FeeCoin:         feeCoin,
+		BaseFee:         baseFee,
+		Err:             p.Err,
+	}, nil
+}
+
+// ParsePrices parses the prices returned by GetPrices, keyed by base denom and then quote denom.
+func ParsePrices(prices map[string]map[string]string) (map[string]map[string]Dec, error) {
+	parsed := make(map[string]map[string]Dec, len(prices))
+	for baseDenom, quotes := range prices {
+		parsedQuotes := make(map[string]Dec, len(quotes))
+		for quoteDenom, price := range quotes {
+			parsedPrice, err := NewDecFromStr(price)
+			if err != nil {
+				return nil, fmt.Errorf("invalid price of %s in %s: %w", baseDenom, quoteDenom, err)
+			}
+			parsedQuotes[quoteDenom] = parsedPrice
+		}
+		parsed[baseDenom] = parsedQuotes
+	}
+
+	return parsed, nil
+}
+
+// parseOptionalDec parses a decimal string, treating an empty string as 0.
+func parseOptionalDec(str string) (Dec, error) {
+	if str == "" {
+		return ZeroDec(), nil
+	}
+	return NewDecFromStr(str)
+}
+
+// parseOptionalInt parses an integer string, treating an empty string as 0.
+func parseOptionalInt(str string) (Int, error) {
+	if str == "" {
+		return ZeroInt(), nil
+	}
+	return NewIntFromStr(str)
+}
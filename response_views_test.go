@@ -0,0 +1,61 @@
+package sqsclient_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestSQSQuoteResponse_Typed(t *testing.T) {
+	quote := sqsclient.SQSQuoteResponse{
+		AmountIn:  sqsclient.Coin{Denom: uosmoDenom, Amount: "1000000"},
+		AmountOut: sqsclient.Coin{Denom: usdcDenom, Amount: "499000"},
+		Route: []sqsclient.Route{{
+			Pools: []sqsclient.Pool{{
+				ID:            1464,
+				Type:          2,
+				SpreadFactor:  "0.0005",
+				TakerFee:      "0.001",
+				TokenOutDenom: usdcDenom,
+			}},
+			InAmount:  "1000000",
+			OutAmount: "499000",
+		}},
+		EffectiveFee:            "0.0015",
+		PriceImpact:             "-0.000123",
+		InBaseOutQuoteSpotPrice: "0.5",
+	}
+
+	typed, err := quote.Typed()
+	require.NoError(t, err)
+	require.Equal(t, uosmoDenom, typed.AmountIn.Denom)
+	require.True(t, typed.AmountIn.Amount.Equal(sqsclient.NewInt(1000000)))
+	require.True(t, typed.AmountOut.Amount.Equal(sqsclient.NewInt(499000)))
+	require.True(t, typed.EffectiveFee.Equal(sqsclient.MustNewDecFromStr("0.0015")))
+	require.True(t, typed.PriceImpact.Equal(sqsclient.MustNewDecFromStr("-0.000123")))
+	require.True(t, typed.InBaseOutQuoteSpotPrice.Equal(sqsclient.MustNewDecFromStr("0.5")))
+	require.True(t, typed.PriceInfo.BaseFee.IsZero())
+
+	require.Len(t, typed.Route, 1)
+	require.True(t, typed.Route[0].OutAmount.Equal(sqsclient.NewInt(499000)))
+	require.Equal(t, uint64(1464), typed.Route[0].Pools[0].ID)
+	require.True(t, typed.Route[0].Pools[0].SpreadFactor.Equal(sqsclient.MustNewDecFromStr("0.0005")))
+	require.True(t, typed.Route[0].Pools[0].TakerFee.Equal(sqsclient.MustNewDecFromStr("0.001")))
+
+	quote.Route[0].Pools[0].TakerFee = "abc"
+	_, err = quote.Typed()
+	require.Error(t, err)
+}
+
+func TestParsePrices(t *testing.T) {
+	prices, err := sqsclient.ParsePrices(map[string]map[string]string{
+		uosmoDenom: {usdcDenom: "0.501234567890123456789"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0.501234567890123456789000000000000000", prices[uosmoDenom][usdcDenom].String())
+
+	_, err = sqsclient.ParsePrices(map[string]map[string]string{uosmoDenom: {usdcDenom: "n/a"}})
+	require.Error(t, err)
+}
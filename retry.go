@@ -0,0 +1,152 @@
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// RetryPolicy configures how failed requests are retried.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry. It doubles on every subsequent retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the exponential backoff.
+	MaxBackoff time.Duration
+	// Jitter is the fraction of the backoff, in [0, 1], that is randomized
+	// to avoid retries from many callers arriving at the same time.
+	Jitter float64
+	// RetryableStatusCodes are the HTTP status codes that are retried.
+	RetryableStatusCodes []int
+	// RetryOnNetworkErrors is whether transport failures, such as connection
+	// resets and timeouts, are retried.
+	RetryOnNetworkErrors bool
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most callers.
+// It makes up to 3 attempts with exponential backoff starting at 100ms,
+// retrying on 429, 502, 503, 504 and network errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 100 * time.Millisecond,
+		MaxBackoff:  2 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryOnNetworkErrors: true,
+	}
+}
+
+// Validate validates the RetryPolicy.
+func (p *RetryPolicy) Validate() error {
+	if p.MaxAttempts < 0 {
+		return errors.New("retry max attempts cannot be negative")
+	}
+
+	if p.BaseBackoff < 0 || p.MaxBackoff < 0 {
+		return errors.New("retry backoff cannot be negative")
+	}
+
+	if p.MaxBackoff > 0 && p.BaseBackoff > p.MaxBackoff {
+		return errors.New("retry base backoff cannot be greater than max backoff")
+	}
+
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return errors.New("retry jitter must be between 0 and 1")
+	}
+
+	return nil
+}
+
+// IsRetryable returns true if the given error should be retried under the policy.
+func (p *RetryPolicy) IsRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return slices.Contains(p.RetryableStatusCodes, apiErr.StatusCode)
+	}
+
+	var requestErr *RequestError
+	if errors.As(err, &requestErr) {
+		// The caller's context is checked separately, so a cancelled or expired
+		// context here is a per-request timeout of the HTTP client.
+		return p.RetryOnNetworkErrors
+	}
+
+	return false
+}
+
+// backoff returns the wait before the given retry, starting at 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	wait := p.BaseBackoff
+	for i := 1; i < retry && (p.MaxBackoff == 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 && wait > 0 {
+		delta := time.Duration(p.Jitter * float64(wait))
+		wait = wait - delta + rand.N(2*delta+1)
+	}
+
+	return wait
+}
+
+// WithRetryPolicy is a helper function to set the retry policy for the sqs client.
+func WithRetryPolicy(policy RetryPolicy, sqs *sqs) *sqs {
+	sqs.retryPolicy = policy
+	return sqs
+}
+
+// doGetWithRetry calls doGetWithHedging, retrying failed attempts according to the client's retry policy.
+// It honors the Retry-After header and gives up early if the context would expire
+// before the next attempt. Every attempt waits for the client's rate limiter.
+func (o *sqs) doGetWithRetry(ctx context.Context, endpoint string, path string) (httpResponse, error) {
+	for attempt := 1; ; attempt++ {
+		if err := o.rateLimiter.Wait(ctx, endpoint); err != nil {
+			return httpResponse{}, err
+		}
+
+		response, err := o.doGetWithHedging(ctx, endpoint, path)
+		if err == nil {
+			return response, nil
+		}
+
+		if attempt >= o.retryPolicy.MaxAttempts || ctx.Err() != nil || !o.retryPolicy.IsRetryable(err) {
+			return httpResponse{}, err
+		}
+
+		wait := o.retryPolicy.backoff(attempt)
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.RetryAfter > wait {
+			wait = apiErr.RetryAfter
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return httpResponse{}, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return httpResponse{}, fmt.Errorf("%w (retry aborted: %w)", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+// newFlakyServer returns a server that responds with the given failing status codes,
+// in order, before responding with 200 and the given body.
+func newFlakyServer(t *testing.T, failures []int, header http.Header, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		if call <= len(failures) {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(failures[call-1])
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func testRetryPolicy() sqsclient.RetryPolicy {
+	policy := sqsclient.DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestRetry_RetryableStatusCodes(t *testing.T) {
+	server, calls := newFlakyServer(t, []int{http.StatusServiceUnavailable, http.StatusBadGateway}, nil, `{"uosmo":{"usdc":"0.5"}}`)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithRetryPolicyOpt(testRetryPolicy()))
+	require.NoError(t, err)
+
+	prices, err := sqs.GetPrices(context.Background(), sqsclient.WithBaseDenom(uosmoDenom))
+	require.NoError(t, err)
+	require.Equal(t, "0.5", prices["uosmo"]["usdc"])
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	server, calls := newFlakyServer(t, []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable}, nil, "{}")
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithRetryPolicyOpt(testRetryPolicy()))
+	require.NoError(t, err)
+
+	_, err = sqs.GetTokensMetadata(context.Background())
+	require.ErrorIs(t, err, sqsclient.ErrServerError)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetry_NonRetryableStatusCode(t *testing.T) {
+	server, calls := newFlakyServer(t, []int{http.StatusBadRequest}, nil, "{}")
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithRetryPolicyOpt(testRetryPolicy()))
+	require.NoError(t, err)
+
+	_, err = sqs.GetTokensMetadata(context.Background())
+	require.ErrorIs(t, err, sqsclient.ErrBadRequest)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetry_DisabledByDefault(t *testing.T) {
+	server, calls := newFlakyServer(t, []int{http.StatusServiceUnavailable}, nil, "{}")
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	_, err = sqs.GetTokensMetadata(context.Background())
+	require.ErrorIs(t, err, sqsclient.ErrServerError)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetry_RetryAfter(t *testing.T) {
+	server, calls := newFlakyServer(t, []int{http.StatusTooManyRequests}, http.Header{"Retry-After": []string{"1"}}, "{}")
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithRetryPolicyOpt(testRetryPolicy()))
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = sqs.GetTokensMetadata(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetry_RespectsContextDeadline(t *testing.T) {
+	server, calls := newFlakyServer(t, []int{http.StatusTooManyRequests}, http.Header{"Retry-After": []string{"10"}}, "{}")
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithRetryPolicyOpt(testRetryPolicy()))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err = sqs.GetTokensMetadata(ctx)
+	require.ErrorIs(t, err, sqsclient.ErrRateLimited)
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	policy := sqsclient.DefaultRetryPolicy()
+	policy.Jitter = 2
+
+	_, err := sqsclient.Initialize(sqsclient.WithRetryPolicyOpt(policy))
+	require.Error(t, err)
+}
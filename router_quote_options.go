@@ -35,6 +35,11 @@ type RouterQuoteOptions struct {
 
 	// AppendBaseFee is whether the base fee is appended to the quote.
 	AppendBaseFee bool
+
+	// err is an error raised while applying an option, returned by Validate.
+	err error
+	// bypassCache is whether a QuoteCache gets a fresh quote. See WithBypassQuoteCache.
+	bypassCache bool
 }
 
 // RouterQuoteOption is the type for the options for the /router/quote endpoint.
@@ -43,6 +48,10 @@ type RouterQuoteOption func(opts *RouterQuoteOptions)
 // Validate validates the RouterQuoteOptions.
 // It returns an error if the options are invalid.
 func (o *RouterQuoteOptions) Validate() error {
+	if o.err != nil {
+		return o.err
+	}
+
 	if o.TokenIn == "" && o.TokenOut == "" {
 		return fmt.Errorf("token in or token out must be set")
 	}
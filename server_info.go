@@ -0,0 +1,196 @@
+package sqsclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultStartupCheckTimeout is the timeout of the startup check when StartupCheck.Timeout is zero.
+const DefaultStartupCheckTimeout = 10 * time.Second
+
+// HealthStatus is the response of the /healthcheck endpoint.
+// SQS responds with an error status code when it is unhealthy, e.g. when it lags behind the chain.
+type HealthStatus struct {
+	GRPCGatewayStatus string      `json:"grpc_gateway_status"`
+	ChainLatestHeight json.Number `json:"chain_latest_height"`
+	StoreLatestHeight json.Number `json:"store_latest_height"`
+}
+
+// ServerVersion is the version of an SQS server, e.g. "v25.1.0" or "25.1.0-rc0".
+type ServerVersion struct {
+	Major int
+	Minor int
+	Patch int
+	// PreRelease is the pre-release suffix, e.g. "rc0", if any.
+	PreRelease string
+	// Raw is the version as returned by SQS.
+	Raw string
+}
+
+// ParseServerVersion parses an SQS version such as "v25.1.0", "25.1" or "v25.1.0-rc0".
+// Build metadata after a "+" is ignored.
+func ParseServerVersion(version string) (ServerVersion, error) {
+	raw := version
+
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	version, _, _ = strings.Cut(version, "+")
+	version, preRelease, _ := strings.Cut(version, "-")
+
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 {
+		return ServerVersion{}, fmt.Errorf("invalid server version %q", raw)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return ServerVersion{}, fmt.Errorf("invalid server version %q", raw)
+		}
+		numbers[i] = number
+	}
+
+	return ServerVersion{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		PreRelease: preRelease,
+		Raw:        raw,
+	}, nil
+}
+
+// Compare returns -1, 0 or 1 if v is lower than, equal to or greater than other.
+// A pre-release is lower than the release it precedes. Pre-releases of the same
+// version are compared lexically.
+func (v ServerVersion) Compare(other ServerVersion) int {
+	for _, diff := range []int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		if diff < 0 {
+			return -1
+		}
+		if diff > 0 {
+			return 1
+		}
+	}
+
+	switch {
+	case v.PreRelease == other.PreRelease:
+		return 0
+	case v.PreRelease == "":
+		return 1
+	case other.PreRelease == "":
+		return -1
+	}
+
+	return strings.Compare(v.PreRelease, other.PreRelease)
+}
+
+// String returns the version as returned by SQS.
+func (v ServerVersion) String() string {
+	return v.Raw
+}
+
+// ServerConfig is the response of the /config endpoint.
+// Only commonly used settings are typed. Raw holds the full configuration.
+type ServerConfig struct {
+	ChainID             string              `json:"chain-id"`
+	GRPCGatewayEndpoint string              `json:"grpc-gateway-endpoint"`
+	Router              ServerRouterConfig  `json:"router"`
+	Pricing             ServerPricingConfig `json:"pricing"`
+
+	// Raw is the configuration as returned by SQS.
+	Raw json.RawMessage `json:"-"`
+}
+
+// ServerRouterConfig is the router configuration of an SQS server.
+type ServerRouterConfig struct {
+	PreferredPoolIDs    []uint64 `json:"preferred-pool-ids"`
+	MaxRoutes           int      `json:"max-routes"`
+	MaxPoolsPerRoute    int      `json:"max-pools-per-route"`
+	MaxSplitRoutes      int      `json:"max-split-routes"`
+	MinPoolLiquidityCap uint64   `json:"min-pool-liquidity-cap"`
+	RouteCacheEnabled   bool     `json:"route-cache-enabled"`
+}
+
+// ServerPricingConfig is the pricing configuration of an SQS server.
+type ServerPricingConfig struct {
+	CacheExpiryMs          int    `json:"cache-expiry-ms"`
+	DefaultSource          int    `json:"default-source"`
+	DefaultQuoteHumanDenom string `json:"default-quote-human-denom"`
+	MinPoolLiquidityCap    uint64 `json:"min-pool-liquidity-cap"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It keeps the raw configuration in Raw.
+func (c *ServerConfig) UnmarshalJSON(data []byte) error {
+	type serverConfig ServerConfig
+
+	var config serverConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return err
+	}
+
+	*c = ServerConfig(config)
+	c.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// StartupCheck configures the check performed by Initialize before returning the client.
+type StartupCheck struct {
+	// MinVersion is the minimum SQS version, e.g. "v25.0.0".
+	// If empty, only the health of the server is checked.
+	MinVersion string
+	// Timeout is the timeout of the whole check.
+	// If zero, DefaultStartupCheckTimeout is used.
+	Timeout time.Duration
+}
+
+// Validate validates the StartupCheck.
+func (c *StartupCheck) Validate() error {
+	if c.Timeout < 0 {
+		return errors.New("startup check timeout cannot be negative")
+	}
+
+	if c.MinVersion != "" {
+		if _, err := ParseServerVersion(c.MinVersion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// run checks that the server is healthy and, if a minimum version is set, that it is recent enough.
+// CONTRACT: Validate() must pass for this to work.
+func (c *StartupCheck) run(client SQSClient) error {
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = DefaultStartupCheckTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if _, err := client.HealthCheck(ctx); err != nil {
+		return fmt.Errorf("startup check failed: %w", err)
+	}
+
+	if c.MinVersion == "" {
+		return nil
+	}
+
+	version, err := client.GetVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("startup check failed: %w", err)
+	}
+
+	minVersion, _ := ParseServerVersion(c.MinVersion)
+	if version.Compare(minVersion) < 0 {
+		return fmt.Errorf("startup check failed: server version %s is below the minimum version %s", version, c.MinVersion)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+// newServerInfoServer returns a server answering the health, version and config endpoints.
+func newServerInfoServer(t *testing.T, healthStatusCode int, version string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthcheck":
+			w.WriteHeader(healthStatusCode)
+			_, _ = w.Write([]byte(`{"grpc_gateway_status":"running","chain_latest_height":"100","store_latest_height":"99"}`))
+		case "/version":
+			_, _ = w.Write([]byte(version))
+		case "/config":
+			_, _ = w.Write([]byte(`{"chain-id":"osmosis-1","router":{"preferred-pool-ids":[1,2],"max-routes":20,"route-cache-enabled":true},"pricing":{"default-quote-human-denom":"usdc"},"otel":{"enabled":false}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestServerInfo(t *testing.T) {
+	server := newServerInfoServer(t, http.StatusOK, "v25.1.0\n")
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	health, err := sqs.HealthCheck(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "running", health.GRPCGatewayStatus)
+	require.Equal(t, "100", health.ChainLatestHeight.String())
+
+	version, err := sqs.GetVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 25, version.Major)
+	require.Equal(t, 1, version.Minor)
+	require.Equal(t, 0, version.Patch)
+	require.Equal(t, "v25.1.0", version.String())
+
+	config, err := sqs.GetServerConfig(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "osmosis-1", config.ChainID)
+	require.Equal(t, []uint64{1, 2}, config.Router.PreferredPoolIDs)
+	require.Equal(t, 20, config.Router.MaxRoutes)
+	require.True(t, config.Router.RouteCacheEnabled)
+	require.Equal(t, "usdc", config.Pricing.DefaultQuoteHumanDenom)
+	require.Contains(t, string(config.Raw), `"otel"`)
+}
+
+func TestGetVersion_JSONString(t *testing.T) {
+	server := newServerInfoServer(t, http.StatusOK, `"25.0.0-rc1"`)
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	version, err := sqs.GetVersion(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "rc1", version.PreRelease)
+}
+
+func TestHealthCheck_Unhealthy(t *testing.T) {
+	server := newServerInfoServer(t, http.StatusServiceUnavailable, "v25.1.0")
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	_, err = sqs.HealthCheck(context.Background())
+	require.ErrorIs(t, err, sqsclient.ErrServerError)
+}
+
+func TestParseServerVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected sqsclient.ServerVersion
+		wantErr  bool
+	}{
+		{version: "v25.1.2", expected: sqsclient.ServerVersion{Major: 25, Minor: 1, Patch: 2, Raw: "v25.1.2"}},
+		{version: "25.1", expected: sqsclient.ServerVersion{Major: 25, Minor: 1, Raw: "25.1"}},
+		{version: "v25.1.0-rc0+abc", expected: sqsclient.ServerVersion{Major: 25, Minor: 1, PreRelease: "rc0", Raw: "v25.1.0-rc0+abc"}},
+		{version: "", wantErr: true},
+		{version: "v25.x", wantErr: true},
+		{version: "1.2.3.4", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.version, func(t *testing.T) {
+			version, err := sqsclient.ParseServerVersion(tc.version)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, version)
+		})
+	}
+}
+
+func TestServerVersion_Compare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "v25.1.0", b: "25.1.0", expected: 0},
+		{a: "v25.1.0", b: "v25.0.9", expected: 1},
+		{a: "v24.9.9", b: "v25.0.0", expected: -1},
+		{a: "v25.0.0-rc1", b: "v25.0.0", expected: -1},
+		{a: "v25.0.0-rc2", b: "v25.0.0-rc1", expected: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			a, err := sqsclient.ParseServerVersion(tc.a)
+			require.NoError(t, err)
+			b, err := sqsclient.ParseServerVersion(tc.b)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, a.Compare(b))
+		})
+	}
+}
+
+func TestWithStartupCheckOpt(t *testing.T) {
+	tests := []struct {
+		name             string
+		healthStatusCode int
+		minVersion       string
+		wantErr          bool
+	}{
+		{name: "healthy", healthStatusCode: http.StatusOK},
+		{name: "unhealthy", healthStatusCode: http.StatusServiceUnavailable, wantErr: true},
+		{name: "recent enough", healthStatusCode: http.StatusOK, minVersion: "v25.0.0"},
+		{name: "too old", healthStatusCode: http.StatusOK, minVersion: "v26.0.0", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newServerInfoServer(t, tc.healthStatusCode, "v25.1.0")
+
+			_, err := sqsclient.Initialize(
+				sqsclient.WithCustomURL(server.URL),
+				sqsclient.WithStartupCheckOpt(sqsclient.StartupCheck{MinVersion: tc.minVersion}),
+			)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	_, err := sqsclient.Initialize(sqsclient.WithStartupCheckOpt(sqsclient.StartupCheck{MinVersion: "latest"}))
+	require.Error(t, err)
+}
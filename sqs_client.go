@@ -2,9 +2,13 @@ package sqsclient
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-
-	"github.com/osmosis-labs/osmoutil-go/httputil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 type sqsExactInQuoteResponse struct {
@@ -27,23 +31,48 @@ type sqsExactOutQuoteResponse struct {
 	PriceInfo               PriceInfo `json:"price_info"`
 }
 
+type poolSpotPriceResponse struct {
+	SpotPrice Dec `json:"spot_price"`
+}
+
 // SQSClient is the interface for the Osmosis Sidecar Query Server (SQSClient) client.
 type SQSClient interface {
-	GetPrices(ctx context.Context, options ...TokenPricesOption) (map[string]map[string]string, error)
-	GetTokensMetadata(ctx context.Context) (map[string]OsmosisTokenMetadata, error)
+	GetPrices(ctx context.Context, options ...TokenPricesOption) (Prices, error)
+	GetTokensMetadata(ctx context.Context, options ...TokensMetadataOption) (map[string]OsmosisTokenMetadata, error)
+	GetTokensPoolMetadata(ctx context.Context, options ...TokensMetadataOption) (map[string]TokenPoolMetadata, error)
 	GetQuote(ctx context.Context, options ...RouterQuoteOption) (SQSQuoteResponse, error)
+	GetQuotes(ctx context.Context, requests []QuoteRequest, options ...BatchOption) ([]QuoteResult, error)
+	GetPools(ctx context.Context, options ...PoolsOption) (PoolsResponse, error)
+	GetPoolSpotPrice(ctx context.Context, poolID uint64, baseDenom, quoteDenom string) (Dec, error)
+	GetCandidateRoutes(ctx context.Context, tokenInDenom, tokenOutDenom string, options ...CandidateRoutesOption) (CandidateRoutes, error)
+	GetCachedRoutes(ctx context.Context, tokenInDenom, tokenOutDenom string, options ...CandidateRoutesOption) (CandidateRoutes, error)
+	GetPoolTicks(ctx context.Context, poolID uint64) (PoolTicks, error)
+	GetActiveOrders(ctx context.Context, address string) (LimitOrders, error)
+	GetHistoricalOrders(ctx context.Context, address string) (LimitOrders, error)
+	GetPortfolioAssets(ctx context.Context, address string) (PortfolioAssets, error)
+	HealthCheck(ctx context.Context) (HealthStatus, error)
+	GetVersion(ctx context.Context) (ServerVersion, error)
+	GetServerConfig(ctx context.Context) (ServerConfig, error)
 }
 
 type sqs struct {
-	url          string
+	backends     *backends
 	apiKeyHeader map[string]string
+	httpClient   *http.Client
+	retryPolicy  RetryPolicy
+	rateLimiter  *RateLimiter
+
+	hedgingPolicy   *HedgingPolicy
+	circuitBreakers *circuitBreakers
 }
 
 // NewClient creates a new OsmosisSQS client.
+// It uses the client returned by NewDefaultHTTPClient unless WithHTTPClient is applied.
 func NewClient(url string) *sqs {
 	return &sqs{
-		url:          url,
+		backends:     newBackends([]string{url}, SelectPriority, DefaultBackendCoolDown),
 		apiKeyHeader: nil,
+		httpClient:   NewDefaultHTTPClient(),
 	}
 }
 
@@ -55,29 +84,120 @@ func WithAPIKey(apiKey string, sqs *sqs) *sqs {
 	return sqs
 }
 
+// WithHTTPClient is a helper function to set the HTTP client used by the sqs client
+// for all requests.
+func WithHTTPClient(httpClient *http.Client, sqs *sqs) *sqs {
+	sqs.httpClient = httpClient
+	return sqs
+}
+
+// WithRateLimiter is a helper function to set the client-side rate limiter for the sqs client.
+func WithRateLimiter(rateLimiter *RateLimiter, sqs *sqs) *sqs {
+	sqs.rateLimiter = rateLimiter
+	return sqs
+}
+
 // GetPrices implements SQSClient
-func (o *sqs) GetPrices(ctx context.Context, options ...TokenPricesOption) (map[string]map[string]string, error) {
+// Base denoms that do not fit in a single URL are fetched in chunks, concurrently.
+func (o *sqs) GetPrices(ctx context.Context, options ...TokenPricesOption) (Prices, error) {
 	// Apply the options
 	opts := TokenPricesOptions{}
 	for _, option := range options {
 		option(&opts)
 	}
 
-	var response map[string]map[string]string
-	if err := o.httpGetWithOptions(ctx, "tokens/prices", &response, &opts); err != nil {
-		return nil, fmt.Errorf("error getting base/USDC price: %v", err)
+	chunks := opts.chunks(MaxPricesQueryLength)
+	if len(chunks) <= 1 {
+		return o.getPrices(ctx, &opts)
+	}
+
+	// Cancels the other chunks on the first error.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]Prices, len(chunks))
+	errs := make([]error, len(chunks))
+	semaphore := make(chan struct{}, DefaultBatchConcurrency)
+
+	var wg sync.WaitGroup
+	for i := range chunks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			results[i], errs[i] = o.getPrices(ctx, &chunks[i])
+			if errs[i] != nil {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Report the error that cancelled the other chunks rather than the cancellation.
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	prices := make(Prices, len(opts.BaseDenoms))
+	for _, result := range results {
+		for baseDenom, quotes := range result {
+			prices[baseDenom] = quotes
+		}
+	}
+
+	return prices, nil
+}
+
+// getPrices makes a single request to the /tokens/prices endpoint.
+func (o *sqs) getPrices(ctx context.Context, opts *TokenPricesOptions) (Prices, error) {
+	var response Prices
+	if err := o.httpGetWithOptions(ctx, TokensPricesEndpoint, &response, opts); err != nil {
+		return nil, fmt.Errorf("error getting base/USDC price: %w", err)
 	}
 
 	return response, nil
 }
 
 // GetTokensMetadata implements SQSClient
-func (o *sqs) GetTokensMetadata(ctx context.Context) (map[string]OsmosisTokenMetadata, error) {
+func (o *sqs) GetTokensMetadata(ctx context.Context, options ...TokensMetadataOption) (map[string]OsmosisTokenMetadata, error) {
+	opts := TokensMetadataOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
 
-	tokenMetadataURL := fmt.Sprintf("%s/tokens/metadata", o.url)
 	var response map[string]OsmosisTokenMetadata
-	if _, err := httputil.Get(ctx, tokenMetadataURL, o.apiKeyHeader, &response); err != nil {
-		return nil, fmt.Errorf("error getting token metadata: %v", err)
+	if err := o.httpGetWithOptions(ctx, TokensMetadataEndpoint, &response, &opts); err != nil {
+		return nil, fmt.Errorf("error getting token metadata: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetTokensPoolMetadata implements SQSClient
+func (o *sqs) GetTokensPoolMetadata(ctx context.Context, options ...TokensMetadataOption) (map[string]TokenPoolMetadata, error) {
+	opts := TokensMetadataOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	var response map[string]TokenPoolMetadata
+	if err := o.httpGetWithOptions(ctx, TokensPoolMetadataEndpoint, &response, &opts); err != nil {
+		return nil, fmt.Errorf("error getting token pool metadata: %w", err)
 	}
 
 	return response, nil
@@ -92,27 +212,213 @@ func (o *sqs) GetQuote(ctx context.Context, options ...RouterQuoteOption) (SQSQu
 
 	var urlExtension string
 	if len(opts.PoolIDs) == 0 {
-		urlExtension = "router/quote"
+		urlExtension = RouterQuoteEndpoint
 	} else {
-		urlExtension = "router/custom-direct-quote"
+		urlExtension = RouterCustomDirectQuoteEndpoint
 	}
 
 	if opts.IsOutGivenIn() {
 		var exactInResponse sqsExactInQuoteResponse
 		if err := o.httpGetWithOptions(ctx, urlExtension, &exactInResponse, &opts); err != nil {
-			return SQSQuoteResponse{}, err
+			return SQSQuoteResponse{}, fmt.Errorf("error getting quote: %w", err)
 		}
 		return convertExactInResponseToQuoteResponse(exactInResponse, opts), nil
 	} else {
 		var exactOutResponse sqsExactOutQuoteResponse
 		if err := o.httpGetWithOptions(ctx, urlExtension, &exactOutResponse, &opts); err != nil {
-			return SQSQuoteResponse{}, err
+			return SQSQuoteResponse{}, fmt.Errorf("error getting quote: %w", err)
 		}
 		return convertExactOutResponseToQuoteResponse(exactOutResponse, opts), nil
 	}
 
 }
 
+// GetPools implements SQSClient
+func (o *sqs) GetPools(ctx context.Context, options ...PoolsOption) (PoolsResponse, error) {
+	opts := PoolsOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	var response PoolsResponse
+	if err := o.httpGetWithOptions(ctx, PoolsEndpoint, &response, &opts); err != nil {
+		return PoolsResponse{}, fmt.Errorf("error getting pools: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetPoolSpotPrice implements SQSClient
+func (o *sqs) GetPoolSpotPrice(ctx context.Context, poolID uint64, baseDenom, quoteDenom string) (Dec, error) {
+	opts := PoolSpotPriceOptions{
+		PoolID:     poolID,
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+	}
+
+	var response poolSpotPriceResponse
+	if err := o.httpGetWithOptions(ctx, RouterSpotPricePoolEndpoint, &response, &opts); err != nil {
+		return Dec{}, fmt.Errorf("error getting pool spot price: %w", err)
+	}
+
+	return response.SpotPrice, nil
+}
+
+// GetCandidateRoutes implements SQSClient
+func (o *sqs) GetCandidateRoutes(ctx context.Context, tokenInDenom, tokenOutDenom string, options ...CandidateRoutesOption) (CandidateRoutes, error) {
+	return o.getCandidateRoutes(ctx, RouterRoutesEndpoint, tokenInDenom, tokenOutDenom, options...)
+}
+
+// GetCachedRoutes implements SQSClient
+func (o *sqs) GetCachedRoutes(ctx context.Context, tokenInDenom, tokenOutDenom string, options ...CandidateRoutesOption) (CandidateRoutes, error) {
+	routes, err := o.getCandidateRoutes(ctx, RouterCachedRoutesEndpoint, tokenInDenom, tokenOutDenom, options...)
+	if err != nil {
+		return CandidateRoutes{}, err
+	}
+
+	routes.Cached = true
+	return routes, nil
+}
+
+// getCandidateRoutes gets the candidate routes for the token pair from the given endpoint.
+func (o *sqs) getCandidateRoutes(ctx context.Context, endpoint string, tokenInDenom, tokenOutDenom string, options ...CandidateRoutesOption) (CandidateRoutes, error) {
+	opts := CandidateRoutesOptions{
+		TokenInDenom:  tokenInDenom,
+		TokenOutDenom: tokenOutDenom,
+	}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	var response CandidateRoutes
+	if err := o.httpGetWithOptions(ctx, endpoint, &response, &opts); err != nil {
+		return CandidateRoutes{}, fmt.Errorf("error getting candidate routes: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetPoolTicks implements SQSClient
+// It makes two requests: the ticks from /pools/ticks/{id}, then the pool from /pools to fill
+// the current tick, current sqrt price and tokens. Both count against the rate limiter, and
+// the latency is that of the two requests.
+func (o *sqs) GetPoolTicks(ctx context.Context, poolID uint64) (PoolTicks, error) {
+	opts := PoolTicksOptions{PoolID: poolID}
+
+	var response PoolTicks
+	if err := o.httpGetWithOptions(ctx, PoolTicksEndpoint, &response, &opts); err != nil {
+		return PoolTicks{}, fmt.Errorf("error getting pool ticks: %w", err)
+	}
+
+	pools, err := o.GetPools(ctx, WithPoolIDs(poolID))
+	if err != nil {
+		return PoolTicks{}, err
+	}
+
+	if len(pools.Data) != 1 {
+		return PoolTicks{}, fmt.Errorf("error getting pool ticks: pool %d not found", poolID)
+	}
+
+	pool := pools.Data[0]
+	if pool.Type != ConcentratedPool {
+		return PoolTicks{}, fmt.Errorf("error getting pool ticks: pool %d is not a concentrated liquidity pool", poolID)
+	}
+
+	var chainModel concentratedPoolChainModel
+	if err := json.Unmarshal(pool.ChainModel, &chainModel); err != nil {
+		return PoolTicks{}, fmt.Errorf("error parsing concentrated liquidity pool %d: %w", poolID, err)
+	}
+
+	currentTick, err := chainModel.CurrentTick.Int64()
+	if err != nil {
+		return PoolTicks{}, fmt.Errorf("error parsing current tick of pool %d: %w", poolID, err)
+	}
+
+	response.CurrentTick = currentTick
+	response.CurrentSqrtPrice = chainModel.CurrentSqrtPrice
+	response.Token0 = chainModel.Token0
+	response.Token1 = chainModel.Token1
+
+	return response, nil
+}
+
+// GetActiveOrders implements SQSClient
+func (o *sqs) GetActiveOrders(ctx context.Context, address string) (LimitOrders, error) {
+	opts := OrdersOptions{Address: address}
+
+	var response LimitOrders
+	if err := o.httpGetWithOptions(ctx, ActiveOrdersEndpoint, &response, &opts); err != nil {
+		return LimitOrders{}, fmt.Errorf("error getting active orders: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetHistoricalOrders implements SQSClient
+func (o *sqs) GetHistoricalOrders(ctx context.Context, address string) (LimitOrders, error) {
+	opts := OrdersOptions{Address: address}
+
+	var response LimitOrders
+	if err := o.httpGetWithOptions(ctx, HistoricalOrdersEndpoint, &response, &opts); err != nil {
+		return LimitOrders{}, fmt.Errorf("error getting historical orders: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetPortfolioAssets implements SQSClient
+func (o *sqs) GetPortfolioAssets(ctx context.Context, address string) (PortfolioAssets, error) {
+	opts := PortfolioAssetsOptions{Address: address}
+
+	var response PortfolioAssets
+	if err := o.httpGetWithOptions(ctx, PortfolioAssetsEndpoint, &response, &opts); err != nil {
+		return PortfolioAssets{}, fmt.Errorf("error getting portfolio assets: %w", err)
+	}
+
+	return response, nil
+}
+
+// HealthCheck implements SQSClient
+func (o *sqs) HealthCheck(ctx context.Context) (HealthStatus, error) {
+	var response HealthStatus
+	if err := o.httpGet(ctx, HealthCheckEndpoint, nil, &response); err != nil {
+		return HealthStatus{}, fmt.Errorf("error checking health: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetVersion implements SQSClient
+func (o *sqs) GetVersion(ctx context.Context) (ServerVersion, error) {
+	var body []byte
+	if err := o.httpGet(ctx, VersionEndpoint, nil, &body); err != nil {
+		return ServerVersion{}, fmt.Errorf("error getting version: %w", err)
+	}
+
+	// SQS returns the version as plain text, but accept a JSON string as well.
+	version := strings.TrimSpace(string(body))
+	if unquoted, err := strconv.Unquote(version); err == nil {
+		version = unquoted
+	}
+
+	parsed, err := ParseServerVersion(version)
+	if err != nil {
+		return ServerVersion{}, fmt.Errorf("error getting version: %w", err)
+	}
+
+	return parsed, nil
+}
+
+// GetServerConfig implements SQSClient
+func (o *sqs) GetServerConfig(ctx context.Context) (ServerConfig, error) {
+	var response ServerConfig
+	if err := o.httpGet(ctx, ConfigEndpoint, nil, &response); err != nil {
+		return ServerConfig{}, fmt.Errorf("error getting server config: %w", err)
+	}
+
+	return response, nil
+}
+
 // convertExactInResponseToQuoteResponse converts an OutGivenIn response to the standard SQSQuoteResponse format
 func convertExactInResponseToQuoteResponse(response sqsExactInQuoteResponse, opts RouterQuoteOptions) SQSQuoteResponse {
 	var outputDenom string
@@ -155,17 +461,17 @@ func convertExactOutResponseToQuoteResponse(response sqsExactOutQuoteResponse, o
 func (o *sqs) httpGetWithOptions(ctx context.Context, endpoint string, response interface{}, options Options) error {
 	// Validate the options
 	if err := options.Validate(); err != nil {
-		return err
+		return fmt.Errorf("%w: %w", ErrInvalidOptions, err)
 	}
 
 	// Create the query params
 	queryParams := options.CreateQueryParams()
 
-	url := fmt.Sprintf("%s/%s?%s", o.url, endpoint, queryParams.Encode())
-
-	if _, err := httputil.Get(ctx, url, o.apiKeyHeader, response); err != nil {
-		return err
+	// Fill in the path params if applicable.
+	path := endpoint
+	if pathParamsOptions, ok := options.(PathParamsOptions); ok {
+		path = pathParamsOptions.CreatePath(endpoint)
 	}
 
-	return nil
+	return o.httpGetPath(ctx, endpoint, path, queryParams, response)
 }
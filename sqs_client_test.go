@@ -113,3 +113,17 @@ func TestGetCustomDirectQuote(t *testing.T) {
 
 	t.Logf("prices: %+v", prices)
 }
+
+func TestGetPools(t *testing.T) {
+	t.Skip("skipping integration test")
+
+	ctx := context.Background()
+
+	sqs, err := sqsclient.Initialize()
+	require.NoError(t, err)
+
+	pools, err := sqs.GetPools(ctx, sqsclient.WithPoolIDs(1, 1135))
+	require.NoError(t, err)
+
+	t.Logf("pools: %+v", pools)
+}
@@ -1,12 +1,43 @@
 package sqsclient
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
 
 // InitializeOptions are the options for the Initialize function.
 type InitializeOptions struct {
 	Environment SQSEnvironment
 	CustomURL   string
 	APIKey      string
+	// HTTPClient is the HTTP client used for all requests.
+	// If nil, the client returned by NewDefaultHTTPClient is used.
+	HTTPClient *http.Client
+	// RetryPolicy is the policy used to retry failed requests.
+	// If nil, failed requests are not retried.
+	RetryPolicy *RetryPolicy
+	// RateLimiter paces requests client-side. If nil, requests are not rate limited.
+	RateLimiter *RateLimiter
+	// CircuitBreaker enables a circuit breaker per endpoint family.
+	// If nil, circuit breakers are disabled.
+	CircuitBreaker *CircuitBreakerConfig
+	// FailoverURLs are base URLs tried in order after the environment or custom URL
+	// when it fails with a connection error or 5xx response.
+	FailoverURLs []string
+	// BackendSelection is how the base URL is picked for each request
+	// when there are failover URLs. Defaults to SelectPriority.
+	BackendSelection BackendSelection
+	// BackendCoolDown is how long a failing base URL is skipped.
+	// If zero, DefaultBackendCoolDown is used.
+	BackendCoolDown time.Duration
+	// HedgingPolicy enables hedged quote requests. If nil, quote requests are not hedged.
+	HedgingPolicy *HedgingPolicy
+	// StartupCheck makes Initialize check the health and version of the server.
+	// If nil, no request is made by Initialize.
+	StartupCheck *StartupCheck
 }
 
 // Validate validates the InitializeOptions.
@@ -19,6 +50,44 @@ func (opts *InitializeOptions) Validate() error {
 		return errors.New("only one of environment or custom url is allowed")
 	}
 
+	if opts.RetryPolicy != nil {
+		if err := opts.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if opts.CircuitBreaker != nil {
+		if err := opts.CircuitBreaker.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, failoverURL := range opts.FailoverURLs {
+		if _, err := url.ParseRequestURI(failoverURL); err != nil {
+			return fmt.Errorf("invalid failover url %q: %w", failoverURL, err)
+		}
+	}
+
+	if opts.BackendSelection < SelectPriority || opts.BackendSelection > SelectLowestLatency {
+		return fmt.Errorf("invalid backend selection %d", opts.BackendSelection)
+	}
+
+	if opts.BackendCoolDown < 0 {
+		return errors.New("backend cool-down cannot be negative")
+	}
+
+	if opts.HedgingPolicy != nil {
+		if err := opts.HedgingPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if opts.StartupCheck != nil {
+		if err := opts.StartupCheck.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -58,6 +127,88 @@ func WithCustomURL(customURL string) InitializeOption {
 	}
 }
 
+// WithHTTPClientOpt is an option to set the HTTP client for the SQS client.
+// Use it to configure timeouts, proxies, TLS or a custom http.RoundTripper.
+func WithHTTPClientOpt(httpClient *http.Client) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.HTTPClient = httpClient
+	}
+}
+
+// WithRetryPolicyOpt is an option to set the retry policy for the SQS client.
+// See DefaultRetryPolicy for a sensible starting point.
+func WithRetryPolicyOpt(policy RetryPolicy) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.RetryPolicy = &policy
+	}
+}
+
+// WithRateLimiterOpt is an option to set the client-side rate limiter for the SQS client.
+// Keep a reference to the rate limiter to monitor it with RateLimiter.Stats.
+func WithRateLimiterOpt(rateLimiter *RateLimiter) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.RateLimiter = rateLimiter
+	}
+}
+
+// WithCircuitBreakerOpt is an option to enable a circuit breaker per endpoint family for the SQS client.
+// See DefaultCircuitBreakerConfig for a sensible starting point.
+func WithCircuitBreakerOpt(config CircuitBreakerConfig) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.CircuitBreaker = &config
+	}
+}
+
+// WithFailoverURLsOpt is an option to add failover base URLs to the SQS client.
+// They are tried in order after the environment or custom URL, and after
+// any failover URLs added before.
+func WithFailoverURLsOpt(urls ...string) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.FailoverURLs = append(opts.FailoverURLs, urls...)
+	}
+}
+
+// WithFailoverEnvironmentsOpt is an option to add the URLs of the given environments
+// as failover base URLs to the SQS client. See WithFailoverURLsOpt.
+func WithFailoverEnvironmentsOpt(environments ...SQSEnvironment) InitializeOption {
+	return func(opts *InitializeOptions) {
+		for _, environment := range environments {
+			opts.FailoverURLs = append(opts.FailoverURLs, EnvironmentURLMap[environment])
+		}
+	}
+}
+
+// WithBackendSelectionOpt is an option to set how the base URL is picked for each request
+// when the SQS client has failover URLs.
+func WithBackendSelectionOpt(selection BackendSelection) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.BackendSelection = selection
+	}
+}
+
+// WithBackendCoolDownOpt is an option to set how long a failing base URL is skipped.
+func WithBackendCoolDownOpt(coolDown time.Duration) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.BackendCoolDown = coolDown
+	}
+}
+
+// WithHedgingOpt is an option to enable hedged quote requests for the SQS client.
+// Set HedgingPolicy.Stats to monitor how often hedges win.
+func WithHedgingOpt(policy HedgingPolicy) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.HedgingPolicy = &policy
+	}
+}
+
+// WithStartupCheckOpt is an option to make Initialize fail fast if the server is unhealthy
+// or, when StartupCheck.MinVersion is set, older than the minimum version.
+func WithStartupCheckOpt(check StartupCheck) InitializeOption {
+	return func(opts *InitializeOptions) {
+		opts.StartupCheck = &check
+	}
+}
+
 // Initialize initializes a new SQS client.
 // It validates the options and returns a new SQS client.
 // If no environment is provided, it defaults to Prod.
@@ -91,5 +242,47 @@ func Initialize(options ...InitializeOption) (SQSClient, error) {
 		sqs = WithAPIKey(opts.APIKey, sqs)
 	}
 
+	// Use the custom HTTP client if applicable.
+	if opts.HTTPClient != nil {
+		sqs = WithHTTPClient(opts.HTTPClient, sqs)
+	}
+
+	// Set the retry policy if applicable.
+	if opts.RetryPolicy != nil {
+		sqs = WithRetryPolicy(*opts.RetryPolicy, sqs)
+	}
+
+	// Set the rate limiter if applicable.
+	if opts.RateLimiter != nil {
+		sqs = WithRateLimiter(opts.RateLimiter, sqs)
+	}
+
+	// Enable the circuit breakers if applicable.
+	if opts.CircuitBreaker != nil {
+		sqs = WithCircuitBreaker(*opts.CircuitBreaker, sqs)
+	}
+
+	// Add the failover URLs if applicable.
+	if len(opts.FailoverURLs) > 0 || opts.BackendSelection != SelectPriority || opts.BackendCoolDown != 0 {
+		coolDown := opts.BackendCoolDown
+		if coolDown == 0 {
+			coolDown = DefaultBackendCoolDown
+		}
+
+		sqs = WithFailover(opts.FailoverURLs, opts.BackendSelection, coolDown, sqs)
+	}
+
+	// Enable hedged quote requests if applicable.
+	if opts.HedgingPolicy != nil {
+		sqs = WithHedging(*opts.HedgingPolicy, sqs)
+	}
+
+	// Check the server if applicable.
+	if opts.StartupCheck != nil {
+		if err := opts.StartupCheck.run(sqs); err != nil {
+			return nil, err
+		}
+	}
+
 	return sqs, nil
 }
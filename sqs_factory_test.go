@@ -0,0 +1,50 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+// countingTransport is an http.RoundTripper that counts the requests it forwards.
+type countingTransport struct {
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestWithHTTPClientOpt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+
+	sqs, err := sqsclient.Initialize(
+		sqsclient.WithCustomURL(server.URL),
+		sqsclient.WithHTTPClientOpt(&http.Client{Transport: transport}),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = sqs.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom))
+	require.NoError(t, err)
+
+	_, err = sqs.GetTokensMetadata(ctx)
+	require.NoError(t, err)
+
+	_, err = sqs.GetQuote(ctx, sqsclient.WithOutGivenIn(1000000, uosmoDenom, uionDenom))
+	require.NoError(t, err)
+
+	require.Equal(t, 3, transport.count)
+}
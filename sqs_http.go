@@ -0,0 +1,175 @@
+package sqsclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultRequestTimeout is the overall timeout of a request made by the default HTTP client.
+	DefaultRequestTimeout = 30 * time.Second
+	// DefaultDialTimeout is the connect timeout of the default HTTP client.
+	DefaultDialTimeout = 5 * time.Second
+	// DefaultResponseHeaderTimeout is the time the default HTTP client waits for response headers.
+	DefaultResponseHeaderTimeout = 20 * time.Second
+	// DefaultKeepAlive is the keep-alive period of connections opened by the default HTTP client.
+	DefaultKeepAlive = 30 * time.Second
+)
+
+// NewDefaultHTTPClient returns the HTTP client used when none is supplied.
+// It sets connect, TLS handshake and read timeouts and keeps idle connections alive
+// so that repeated calls to SQS reuse them.
+func NewDefaultHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   DefaultDialTimeout,
+		KeepAlive: DefaultKeepAlive,
+	}
+
+	return &http.Client{
+		Timeout: DefaultRequestTimeout,
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   10,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   DefaultDialTimeout,
+			ResponseHeaderTimeout: DefaultResponseHeaderTimeout,
+			ExpectContinueTimeout: time.Second,
+		},
+	}
+}
+
+// httpGet makes an HTTP GET request to the given endpoint with the given query params,
+// parsing the response into the given response parameter.
+// Failed requests fail over to the next base URL and are retried according to
+// the client's retry policy, behind the circuit breaker of the endpoint family if enabled.
+// Non-200 responses are returned as *APIError, transport failures as *RequestError
+// and decoding failures as *DecodeError.
+func (o *sqs) httpGet(ctx context.Context, endpoint string, queryParams url.Values, response interface{}) error {
+	return o.httpGetPath(ctx, endpoint, endpoint, queryParams, response)
+}
+
+// httpGetPath is like httpGet for endpoints with path parameters. The endpoint, e.g.
+// "router/spot-price-pool/{id}", identifies the endpoint in errors and per-endpoint
+// configuration, while the path, e.g. "router/spot-price-pool/1", is requested.
+// If response is a *[]byte, it is set to the raw response body instead of being decoded.
+func (o *sqs) httpGetPath(ctx context.Context, endpoint string, path string, queryParams url.Values, response interface{}) error {
+	if len(queryParams) > 0 {
+		path = fmt.Sprintf("%s?%s", path, queryParams.Encode())
+	}
+
+	httpResp, err := o.circuitBreakers.execute(ctx, endpoint, func() (httpResponse, error) {
+		return o.doGetWithRetry(ctx, endpoint, path)
+	})
+	if err != nil {
+		return err
+	}
+	body := httpResp.body
+
+	if response == nil {
+		return nil
+	}
+
+	// Plain-text responses are returned as is.
+	if raw, ok := response.(*[]byte); ok {
+		*raw = body
+		return nil
+	}
+
+	if err := json.Unmarshal(body, response); err != nil {
+		return &DecodeError{Endpoint: endpoint, URL: httpResp.url, Body: body, Err: err}
+	}
+
+	return nil
+}
+
+// httpResponse is the body of a successful response with the URL that served it.
+type httpResponse struct {
+	body []byte
+	// url is the requested URL on the backend that served the response.
+	url string
+}
+
+// doGet makes a single HTTP GET request to the given URL and returns the response body.
+func (o *sqs) doGet(ctx context.Context, endpoint string, requestURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, &RequestError{Endpoint: endpoint, URL: requestURL, Err: err}
+	}
+
+	for key, value := range o.apiKeyHeader {
+		req.Header[key] = []string{value}
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, &RequestError{Endpoint: endpoint, URL: requestURL, Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &RequestError{Endpoint: endpoint, URL: requestURL, Err: err}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    parseErrorMessage(body),
+			Endpoint:   endpoint,
+			URL:        requestURL,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	return body, nil
+}
+
+// sqsErrorResponse is the error body returned by SQS.
+type sqsErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// parseErrorMessage extracts the error message from an SQS error body.
+// If the body is not a JSON error message, the raw body is returned.
+func parseErrorMessage(body []byte) string {
+	var errResponse sqsErrorResponse
+	if err := json.Unmarshal(body, &errResponse); err == nil && errResponse.Message != "" {
+		return errResponse.Message
+	}
+
+	return strings.TrimSpace(string(body))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, given either in
+// seconds or as an HTTP date. It returns zero if the header is absent or invalid.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
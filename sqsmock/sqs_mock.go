@@ -2,24 +2,55 @@ package sqsmock
 
 import (
 	"context"
+	"fmt"
 
 	sqsclient "github.com/osmosis-labs/sqs-go-client"
 )
 
 // SQSMock is a mock implementation of the sqsclient.SQSClient interface.
 type SQSMock struct {
-	GetPricesFunc         func(ctx context.Context, options ...sqsclient.TokenPricesOption) (map[string]map[string]string, error)
-	GetQuoteFunc          func(ctx context.Context, options ...sqsclient.RouterQuoteOption) (sqsclient.SQSQuoteResponse, error)
-	GetTokensMetadataFunc func(ctx context.Context) (map[string]sqsclient.OsmosisTokenMetadata, error)
+	GetPricesFunc             func(ctx context.Context, options ...sqsclient.TokenPricesOption) (map[string]map[string]string, error)
+	GetQuoteFunc              func(ctx context.Context, options ...sqsclient.RouterQuoteOption) (sqsclient.SQSQuoteResponse, error)
+	GetQuotesFunc             func(ctx context.Context, requests []sqsclient.QuoteRequest, options ...sqsclient.BatchOption) ([]sqsclient.QuoteResult, error)
+	GetTokensMetadataFunc     func(ctx context.Context, options ...sqsclient.TokensMetadataOption) (map[string]sqsclient.OsmosisTokenMetadata, error)
+	GetTokensPoolMetadataFunc func(ctx context.Context, options ...sqsclient.TokensMetadataOption) (map[string]sqsclient.TokenPoolMetadata, error)
+	GetPoolsFunc              func(ctx context.Context, options ...sqsclient.PoolsOption) (sqsclient.PoolsResponse, error)
+	GetPoolSpotPriceFunc      func(ctx context.Context, poolID uint64, baseDenom, quoteDenom string) (sqsclient.Dec, error)
+
+	GetCandidateRoutesFunc func(ctx context.Context, tokenInDenom, tokenOutDenom string, options ...sqsclient.CandidateRoutesOption) (sqsclient.CandidateRoutes, error)
+	GetCachedRoutesFunc    func(ctx context.Context, tokenInDenom, tokenOutDenom string, options ...sqsclient.CandidateRoutesOption) (sqsclient.CandidateRoutes, error)
+	GetPoolTicksFunc       func(ctx context.Context, poolID uint64) (sqsclient.PoolTicks, error)
+
+	GetActiveOrdersFunc     func(ctx context.Context, address string) (sqsclient.LimitOrders, error)
+	GetHistoricalOrdersFunc func(ctx context.Context, address string) (sqsclient.LimitOrders, error)
+	GetPortfolioAssetsFunc  func(ctx context.Context, address string) (sqsclient.PortfolioAssets, error)
+
+	HealthCheckFunc     func(ctx context.Context) (sqsclient.HealthStatus, error)
+	GetVersionFunc      func(ctx context.Context) (sqsclient.ServerVersion, error)
+	GetServerConfigFunc func(ctx context.Context) (sqsclient.ServerConfig, error)
+
+	// Err is returned by every method that has no mock function set.
+	Err error
+}
+
+// NewAPIError returns an *sqsclient.APIError for the given endpoint, status code and message,
+// matching what the real client returns for a non-200 response.
+func NewAPIError(endpoint string, statusCode int, message string) *sqsclient.APIError {
+	return &sqsclient.APIError{
+		StatusCode: statusCode,
+		Message:    message,
+		Endpoint:   endpoint,
+		URL:        fmt.Sprintf("%s/%s", sqsclient.DefaultProdURL, endpoint),
+	}
 }
 
 // GetPrices implements sqsclient.SQSClient.
-func (s *SQSMock) GetPrices(ctx context.Context, options ...sqsclient.TokenPricesOption) (map[string]map[string]string, error) {
+func (s *SQSMock) GetPrices(ctx context.Context, options ...sqsclient.TokenPricesOption) (sqsclient.Prices, error) {
 	if s.GetPricesFunc != nil {
 		return s.GetPricesFunc(ctx, options...)
 	}
 
-	return nil, nil
+	return nil, s.Err
 }
 
 // GetQuote implements sqsclient.SQSClient.
@@ -28,16 +59,133 @@ func (s *SQSMock) GetQuote(ctx context.Context, options ...sqsclient.RouterQuote
 		return s.GetQuoteFunc(ctx, options...)
 	}
 
-	return sqsclient.SQSQuoteResponse{}, nil
+	return sqsclient.SQSQuoteResponse{}, s.Err
+}
+
+// GetQuotes implements sqsclient.SQSClient.
+func (s *SQSMock) GetQuotes(ctx context.Context, requests []sqsclient.QuoteRequest, options ...sqsclient.BatchOption) ([]sqsclient.QuoteResult, error) {
+	if s.GetQuotesFunc != nil {
+		return s.GetQuotesFunc(ctx, requests, options...)
+	}
+
+	return nil, s.Err
 }
 
 // GetTokensMetadata implements sqsclient.SQSClient.
-func (s *SQSMock) GetTokensMetadata(ctx context.Context) (map[string]sqsclient.OsmosisTokenMetadata, error) {
+func (s *SQSMock) GetTokensMetadata(ctx context.Context, options ...sqsclient.TokensMetadataOption) (map[string]sqsclient.OsmosisTokenMetadata, error) {
 	if s.GetTokensMetadataFunc != nil {
-		return s.GetTokensMetadataFunc(ctx)
+		return s.GetTokensMetadataFunc(ctx, options...)
+	}
+
+	return nil, s.Err
+}
+
+// GetTokensPoolMetadata implements sqsclient.SQSClient.
+func (s *SQSMock) GetTokensPoolMetadata(ctx context.Context, options ...sqsclient.TokensMetadataOption) (map[string]sqsclient.TokenPoolMetadata, error) {
+	if s.GetTokensPoolMetadataFunc != nil {
+		return s.GetTokensPoolMetadataFunc(ctx, options...)
+	}
+
+	return nil, s.Err
+}
+
+// GetPools implements sqsclient.SQSClient.
+func (s *SQSMock) GetPools(ctx context.Context, options ...sqsclient.PoolsOption) (sqsclient.PoolsResponse, error) {
+	if s.GetPoolsFunc != nil {
+		return s.GetPoolsFunc(ctx, options...)
+	}
+
+	return sqsclient.PoolsResponse{}, s.Err
+}
+
+// GetPoolSpotPrice implements sqsclient.SQSClient.
+func (s *SQSMock) GetPoolSpotPrice(ctx context.Context, poolID uint64, baseDenom, quoteDenom string) (sqsclient.Dec, error) {
+	if s.GetPoolSpotPriceFunc != nil {
+		return s.GetPoolSpotPriceFunc(ctx, poolID, baseDenom, quoteDenom)
+	}
+
+	return sqsclient.Dec{}, s.Err
+}
+
+// GetCandidateRoutes implements sqsclient.SQSClient.
+func (s *SQSMock) GetCandidateRoutes(ctx context.Context, tokenInDenom, tokenOutDenom string, options ...sqsclient.CandidateRoutesOption) (sqsclient.CandidateRoutes, error) {
+	if s.GetCandidateRoutesFunc != nil {
+		return s.GetCandidateRoutesFunc(ctx, tokenInDenom, tokenOutDenom, options...)
+	}
+
+	return sqsclient.CandidateRoutes{}, s.Err
+}
+
+// GetCachedRoutes implements sqsclient.SQSClient.
+func (s *SQSMock) GetCachedRoutes(ctx context.Context, tokenInDenom, tokenOutDenom string, options ...sqsclient.CandidateRoutesOption) (sqsclient.CandidateRoutes, error) {
+	if s.GetCachedRoutesFunc != nil {
+		return s.GetCachedRoutesFunc(ctx, tokenInDenom, tokenOutDenom, options...)
+	}
+
+	return sqsclient.CandidateRoutes{}, s.Err
+}
+
+// GetPoolTicks implements sqsclient.SQSClient.
+func (s *SQSMock) GetPoolTicks(ctx context.Context, poolID uint64) (sqsclient.PoolTicks, error) {
+	if s.GetPoolTicksFunc != nil {
+		return s.GetPoolTicksFunc(ctx, poolID)
+	}
+
+	return sqsclient.PoolTicks{}, s.Err
+}
+
+// GetActiveOrders implements sqsclient.SQSClient.
+func (s *SQSMock) GetActiveOrders(ctx context.Context, address string) (sqsclient.LimitOrders, error) {
+	if s.GetActiveOrdersFunc != nil {
+		return s.GetActiveOrdersFunc(ctx, address)
+	}
+
+	return sqsclient.LimitOrders{}, s.Err
+}
+
+// GetHistoricalOrders implements sqsclient.SQSClient.
+func (s *SQSMock) GetHistoricalOrders(ctx context.Context, address string) (sqsclient.LimitOrders, error) {
+	if s.GetHistoricalOrdersFunc != nil {
+		return s.GetHistoricalOrdersFunc(ctx, address)
+	}
+
+	return sqsclient.LimitOrders{}, s.Err
+}
+
+// GetPortfolioAssets implements sqsclient.SQSClient.
+func (s *SQSMock) GetPortfolioAssets(ctx context.Context, address string) (sqsclient.PortfolioAssets, error) {
+	if s.GetPortfolioAssetsFunc != nil {
+		return s.GetPortfolioAssetsFunc(ctx, address)
+	}
+
+	return sqsclient.PortfolioAssets{}, s.Err
+}
+
+// HealthCheck implements sqsclient.SQSClient.
+func (s *SQSMock) HealthCheck(ctx context.Context) (sqsclient.HealthStatus, error) {
+	if s.HealthCheckFunc != nil {
+		return s.HealthCheckFunc(ctx)
+	}
+
+	return sqsclient.HealthStatus{}, s.Err
+}
+
+// GetVersion implements sqsclient.SQSClient.
+func (s *SQSMock) GetVersion(ctx context.Context) (sqsclient.ServerVersion, error) {
+	if s.GetVersionFunc != nil {
+		return s.GetVersionFunc(ctx)
+	}
+
+	return sqsclient.ServerVersion{}, s.Err
+}
+
+// GetServerConfig implements sqsclient.SQSClient.
+func (s *SQSMock) GetServerConfig(ctx context.Context) (sqsclient.ServerConfig, error) {
+	if s.GetServerConfigFunc != nil {
+		return s.GetServerConfigFunc(ctx)
 	}
 
-	return nil, nil
+	return sqsclient.ServerConfig{}, s.Err
 }
 
 var _ sqsclient.SQSClient = (*SQSMock)(nil)
@@ -0,0 +1,65 @@
+package sqsclient
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// TokensMetadataOptions is the type for the options for the /tokens/metadata endpoint.
+type TokensMetadataOptions struct {
+	// Denoms is a list of denoms to get the metadata for. If empty, the metadata of all tokens is returned.
+	Denoms []string
+
+	// HumanDenoms is whether Denoms are human denoms, e.g. "osmo", rather than chain denoms.
+	HumanDenoms bool
+}
+
+// TokensMetadataOption is the type for the options for the /tokens/metadata endpoint.
+type TokensMetadataOption func(opts *TokensMetadataOptions)
+
+// WithMetadataDenoms is an option to only get the metadata of the given denoms from the /tokens/metadata endpoint.
+func WithMetadataDenoms(denoms ...string) TokensMetadataOption {
+	return func(opts *TokensMetadataOptions) {
+		opts.Denoms = denoms
+	}
+}
+
+// WithHumanDenomsMetadata is an option to look up the denoms given to WithMetadataDenoms by human denom.
+func WithHumanDenomsMetadata() TokensMetadataOption {
+	return func(opts *TokensMetadataOptions) {
+		opts.HumanDenoms = true
+	}
+}
+
+// Validate validates the options for the /tokens/metadata endpoint.
+func (opts *TokensMetadataOptions) Validate() error {
+	if opts.HumanDenoms && len(opts.Denoms) == 0 {
+		return fmt.Errorf("denoms are required when looking up human denoms")
+	}
+
+	for _, denom := range opts.Denoms {
+		if denom == "" {
+			return fmt.Errorf("denom cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// CreateQueryParams creates the query params for the /tokens/metadata endpoint.
+func (opts *TokensMetadataOptions) CreateQueryParams() url.Values {
+	queryParams := url.Values{}
+
+	if len(opts.Denoms) > 0 {
+		queryParams.Add("denoms", strings.Join(opts.Denoms, ","))
+	}
+
+	if opts.HumanDenoms {
+		queryParams.Add("humanDenoms", "true")
+	}
+
+	return queryParams
+}
+
+var _ Options = &TokensMetadataOptions{}
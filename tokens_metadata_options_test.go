@@ -0,0 +1,59 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestTokensMetadataOptions_CreateQueryParams(t *testing.T) {
+	options := &sqsclient.TokensMetadataOptions{}
+	for _, opt := range []sqsclient.TokensMetadataOption{
+		sqsclient.WithMetadataDenoms("osmo", "atom"),
+		sqsclient.WithHumanDenomsMetadata(),
+	} {
+		opt(options)
+	}
+
+	require.NoError(t, options.Validate())
+
+	queryParams := options.CreateQueryParams()
+	require.Equal(t, "osmo,atom", queryParams.Get("denoms"))
+	require.Equal(t, "true", queryParams.Get("humanDenoms"))
+
+	// No options get the metadata of all tokens.
+	require.Empty(t, (&sqsclient.TokensMetadataOptions{}).CreateQueryParams())
+}
+
+func TestTokensMetadataOptions_Validate(t *testing.T) {
+	options := &sqsclient.TokensMetadataOptions{HumanDenoms: true}
+	require.Error(t, options.Validate())
+
+	options = &sqsclient.TokensMetadataOptions{Denoms: []string{uosmoDenom, ""}}
+	require.Error(t, options.Validate())
+}
+
+func TestGetTokensMetadata_Denoms(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/tokens/metadata", r.URL.Path)
+		require.Equal(t, uosmoDenom+","+atomDenom, r.URL.Query().Get("denoms"))
+		require.Equal(t, "key", r.Header.Get("x-api-key"))
+		_, _ = w.Write([]byte(`{"uosmo":{"name":"Osmosis","symbol":"OSMO","coinMinimalDenom":"uosmo","decimals":6}}`))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL), sqsclient.WithAPIKeyOpt("key"))
+	require.NoError(t, err)
+
+	metadata, err := sqs.GetTokensMetadata(context.Background(), sqsclient.WithMetadataDenoms(uosmoDenom, atomDenom))
+	require.NoError(t, err)
+	require.Equal(t, "OSMO", metadata[uosmoDenom].Symbol)
+
+	_, err = sqs.GetTokensMetadata(context.Background(), sqsclient.WithHumanDenomsMetadata())
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
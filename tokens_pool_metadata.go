@@ -0,0 +1,52 @@
+package sqsclient
+
+import (
+	"sort"
+)
+
+// TokenPoolMetadata is the pool metadata of a token, as returned by the /tokens/pool-metadata endpoint.
+type TokenPoolMetadata struct {
+	// TotalLiquidity is the amount of the token in all pools, in base units.
+	TotalLiquidity Dec `json:"total_liquidity"`
+	// TotalLiquidityCap is the value of the token in all pools, in USD.
+	TotalLiquidityCap Dec `json:"total_liquidity_cap"`
+	// Price is the price of the token, in USD.
+	Price Dec `json:"price"`
+	// PoolCount is the number of pools containing the token.
+	PoolCount uint64 `json:"pool_count"`
+}
+
+// TokenWithPoolMetadata is a token with its metadata and pool metadata.
+type TokenWithPoolMetadata struct {
+	Denom        string
+	Metadata     OsmosisTokenMetadata
+	PoolMetadata TokenPoolMetadata
+}
+
+// RankTokensByLiquidity joins the token metadata returned by GetTokensMetadata with the
+// pool metadata returned by GetTokensPoolMetadata, sorted by total liquidity capitalization
+// in descending order. Ties are sorted by denom. Tokens without pool metadata are skipped.
+func RankTokensByLiquidity(tokensMetadata map[string]OsmosisTokenMetadata, poolMetadata map[string]TokenPoolMetadata) []TokenWithPoolMetadata {
+	tokens := make([]TokenWithPoolMetadata, 0, len(poolMetadata))
+	for denom, metadata := range tokensMetadata {
+		tokenPoolMetadata, ok := poolMetadata[denom]
+		if !ok {
+			continue
+		}
+
+		tokens = append(tokens, TokenWithPoolMetadata{
+			Denom:        denom,
+			Metadata:     metadata,
+			PoolMetadata: tokenPoolMetadata,
+		})
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		if cmp := tokens[i].PoolMetadata.TotalLiquidityCap.Cmp(tokens[j].PoolMetadata.TotalLiquidityCap); cmp != 0 {
+			return cmp > 0
+		}
+		return tokens[i].Denom < tokens[j].Denom
+	})
+
+	return tokens
+}
@@ -0,0 +1,54 @@
+package sqsclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+const tokensPoolMetadataResponse = `{
+	"uosmo": {"total_liquidity": "1000000000000", "total_liquidity_cap": "500000.5", "price": "0.5", "pool_count": 120},
+	"uion": {"total_liquidity": "1000000", "total_liquidity_cap": "2000", "price": "2"},
+	"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2": {"total_liquidity": "100000000000", "total_liquidity_cap": "900000", "price": "9", "pool_count": 40}
+}`
+
+func TestGetTokensPoolMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/tokens/pool-metadata", r.URL.Path)
+		require.Equal(t, uosmoDenom+","+uionDenom+","+atomDenom, r.URL.Query().Get("denoms"))
+		_, _ = w.Write([]byte(tokensPoolMetadataResponse))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	poolMetadata, err := sqs.GetTokensPoolMetadata(context.Background(), sqsclient.WithMetadataDenoms(uosmoDenom, uionDenom, atomDenom))
+	require.NoError(t, err)
+	require.Len(t, poolMetadata, 3)
+
+	osmo := poolMetadata[uosmoDenom]
+	require.Equal(t, sqsclient.MustNewDecFromStr("1000000000000").String(), osmo.TotalLiquidity.String())
+	require.Equal(t, sqsclient.MustNewDecFromStr("500000.5").String(), osmo.TotalLiquidityCap.String())
+	require.Equal(t, sqsclient.MustNewDecFromStr("0.5").String(), osmo.Price.String())
+	require.Equal(t, uint64(120), osmo.PoolCount)
+
+	tokensMetadata := map[string]sqsclient.OsmosisTokenMetadata{
+		uosmoDenom: {Symbol: "OSMO", Decimals: 6},
+		uionDenom:  {Symbol: "ION", Decimals: 6},
+		atomDenom:  {Symbol: "ATOM", Decimals: 6},
+		usdcDenom:  {Symbol: "USDC", Decimals: 6},
+	}
+
+	ranked := sqsclient.RankTokensByLiquidity(tokensMetadata, poolMetadata)
+	require.Len(t, ranked, 3)
+	require.Equal(t, "ATOM", ranked[0].Metadata.Symbol)
+	require.Equal(t, "OSMO", ranked[1].Metadata.Symbol)
+	require.Equal(t, uionDenom, ranked[2].Denom)
+	require.Equal(t, uint64(40), ranked[0].PoolMetadata.PoolCount)
+}
@@ -3,9 +3,36 @@ package sqsclient
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
+// MaxPricesQueryLength is the maximum length of the query string of a request to the
+// /tokens/prices endpoint. GetPrices splits base denoms that do not fit into several requests.
+const MaxPricesQueryLength = 4000
+
+// PricingSource is a source of token prices in SQS.
+type PricingSource int
+
+const (
+	// PricingSourceChain prices tokens by routing through Osmosis pools.
+	PricingSourceChain PricingSource = 0
+	// PricingSourceCoinGecko prices tokens with CoinGecko.
+	PricingSourceCoinGecko PricingSource = 1
+)
+
+// String returns the name of the pricing source.
+func (s PricingSource) String() string {
+	switch s {
+	case PricingSourceChain:
+		return "chain"
+	case PricingSourceCoinGecko:
+		return "coingecko"
+	default:
+		return fmt.Sprintf("PricingSource(%d)", int(s))
+	}
+}
+
 // TokenPricesOptions is the type for the options for the /tokens/prices endpoint.
 type TokenPricesOptions struct {
 	// HumanDenoms is a flag to set the human denoms for the /tokens/prices endpoint.
@@ -13,6 +40,13 @@ type TokenPricesOptions struct {
 
 	// BaseDenoms is a list of base denoms to get the prices for.
 	BaseDenoms []string
+
+	// PricingSource is the source of the prices. If nil, the default source of the server is used.
+	PricingSource *PricingSource
+
+	// QuoteDenom is the denom to price the base denoms in. SQS only supports USDC, i.e.
+	// USDCDenom, or USDCHumanDenom if HumanDenoms is set. If empty, USDC is used.
+	QuoteDenom string
 }
 
 // TokenPricesOption is the type for the options for the /tokens/prices endpoint.
@@ -37,6 +71,20 @@ func WithBaseDenom(denom string) TokenPricesOption {
 	return WithBaseDenoms([]string{denom})
 }
 
+// WithPricingSource is an option to set the pricing source for the /tokens/prices endpoint.
+func WithPricingSource(source PricingSource) TokenPricesOption {
+	return func(opts *TokenPricesOptions) {
+		opts.PricingSource = &source
+	}
+}
+
+// WithQuoteDenom is an option to set the quote denom for the /tokens/prices endpoint.
+func WithQuoteDenom(denom string) TokenPricesOption {
+	return func(opts *TokenPricesOptions) {
+		opts.QuoteDenom = denom
+	}
+}
+
 // Validate validates the options for the /tokens/prices endpoint.
 func (opts *TokenPricesOptions) Validate() error {
 
@@ -44,6 +92,21 @@ func (opts *TokenPricesOptions) Validate() error {
 		return fmt.Errorf("base denoms is required")
 	}
 
+	if opts.PricingSource != nil && *opts.PricingSource != PricingSourceChain && *opts.PricingSource != PricingSourceCoinGecko {
+		return fmt.Errorf("unsupported pricing source %s", *opts.PricingSource)
+	}
+
+	if opts.QuoteDenom != "" {
+		supportedQuoteDenom := USDCDenom
+		if opts.HumanDenoms {
+			supportedQuoteDenom = USDCHumanDenom
+		}
+
+		if opts.QuoteDenom != supportedQuoteDenom {
+			return fmt.Errorf("unsupported quote denom %s, expected %s", opts.QuoteDenom, supportedQuoteDenom)
+		}
+	}
+
 	return nil
 }
 
@@ -58,7 +121,52 @@ func (opts *TokenPricesOptions) CreateQueryParams() url.Values {
 
 	queryParams.Add("base", strings.Join(opts.BaseDenoms, ","))
 
+	if opts.PricingSource != nil {
+		queryParams.Add("pricingSource", strconv.Itoa(int(*opts.PricingSource)))
+	}
+
+	if opts.QuoteDenom != "" {
+		queryParams.Add("quote", opts.QuoteDenom)
+	}
+
 	return queryParams
 }
 
+// chunks splits the options into options with the same settings and as many base denoms
+// as fit in a query string of the given length. A base denom too long to fit on its own
+// gets its own chunk.
+func (opts *TokenPricesOptions) chunks(maxQueryLength int) []TokenPricesOptions {
+	if len(opts.BaseDenoms) <= 1 {
+		return []TokenPricesOptions{*opts}
+	}
+
+	// The length of the query string without the base denoms, i.e. "...&base=".
+	settings := *opts
+	settings.BaseDenoms = nil
+	queryLength := len(settings.CreateQueryParams().Encode())
+
+	var (
+		chunks      []TokenPricesOptions
+		chunk       []string
+		chunkLength int
+	)
+	for _, denom := range opts.BaseDenoms {
+		// Each denom is preceded by an encoded comma, "%2C".
+		denomLength := len(url.QueryEscape(denom)) + 3
+
+		if len(chunk) > 0 && queryLength+chunkLength+denomLength > maxQueryLength {
+			chunks = append(chunks, settings)
+			chunks[len(chunks)-1].BaseDenoms = chunk
+			chunk, chunkLength = nil, 0
+		}
+
+		chunk = append(chunk, denom)
+		chunkLength += denomLength
+	}
+
+	last := settings
+	last.BaseDenoms = chunk
+	return append(chunks, last)
+}
+
 var _ Options = &TokenPricesOptions{}
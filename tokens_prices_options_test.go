@@ -0,0 +1,174 @@
+package sqsclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqsclient "github.com/osmosis-labs/sqs-go-client"
+)
+
+func TestTokenPricesOptions_CreateQueryParams(t *testing.T) {
+	options := &sqsclient.TokenPricesOptions{}
+	for _, opt := range []sqsclient.TokenPricesOption{
+		sqsclient.WithBaseDenoms([]string{uosmoDenom, atomDenom}),
+		sqsclient.WithPricingSource(sqsclient.PricingSourceCoinGecko),
+		sqsclient.WithQuoteDenom(usdcDenom),
+	} {
+		opt(options)
+	}
+
+	require.NoError(t, options.Validate())
+
+	queryParams := options.CreateQueryParams()
+	require.Equal(t, uosmoDenom+","+atomDenom, queryParams.Get("base"))
+	require.Equal(t, "false", queryParams.Get("humanDenoms"))
+	require.Equal(t, "1", queryParams.Get("pricingSource"))
+	require.Equal(t, usdcDenom, queryParams.Get("quote"))
+
+	// The server defaults are used if not set.
+	options = &sqsclient.TokenPricesOptions{BaseDenoms: []string{uosmoDenom}}
+	queryParams = options.CreateQueryParams()
+	require.False(t, queryParams.Has("pricingSource"))
+	require.False(t, queryParams.Has("quote"))
+}
+
+func TestTokenPricesOptions_Validate(t *testing.T) {
+	source := sqsclient.PricingSource(5)
+	options := &sqsclient.TokenPricesOptions{BaseDenoms: []string{uosmoDenom}, PricingSource: &source}
+	require.Error(t, options.Validate())
+
+	// Only USDC is supported as quote denom, in the form of the base denoms.
+	options = &sqsclient.TokenPricesOptions{BaseDenoms: []string{uosmoDenom}, QuoteDenom: sqsclient.USDCDenom}
+	require.NoError(t, options.Validate())
+
+	options = &sqsclient.TokenPricesOptions{BaseDenoms: []string{"osmo"}, QuoteDenom: sqsclient.USDCHumanDenom, HumanDenoms: true}
+	require.NoError(t, options.Validate())
+
+	options = &sqsclient.TokenPricesOptions{BaseDenoms: []string{uosmoDenom}, QuoteDenom: atomDenom}
+	require.Error(t, options.Validate())
+
+	options = &sqsclient.TokenPricesOptions{BaseDenoms: []string{uosmoDenom}, QuoteDenom: sqsclient.USDCHumanDenom}
+	require.Error(t, options.Validate())
+}
+
+func TestGetPrices_UnsupportedQuoteDenom(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, usdcDenom, r.URL.Query().Get("quote"))
+		_, _ = w.Write([]byte(`{"uosmo":{"` + usdcDenom + `":"0.5"}}`))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	prices, err := sqs.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom), sqsclient.WithQuoteDenom(usdcDenom))
+	require.NoError(t, err)
+	require.Equal(t, "0.5", prices[uosmoDenom][usdcDenom])
+
+	_, err = sqs.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom), sqsclient.WithQuoteDenom(atomDenom))
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
+
+func TestGetPrices_PricingSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/tokens/prices", r.URL.Path)
+
+		switch r.URL.Query().Get("pricingSource") {
+		case "0":
+			_, _ = w.Write([]byte(`{"uosmo":{"` + usdcDenom + `":"0.501"}}`))
+		case "1":
+			_, _ = w.Write([]byte(`{"uosmo":{"` + usdcDenom + `":"0.499"}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	chainPrices, err := sqs.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom), sqsclient.WithPricingSource(sqsclient.PricingSourceChain))
+	require.NoError(t, err)
+	require.Equal(t, "0.501", chainPrices[uosmoDenom][usdcDenom])
+
+	coinGeckoPrices, err := sqs.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom), sqsclient.WithPricingSource(sqsclient.PricingSourceCoinGecko))
+	require.NoError(t, err)
+	require.Equal(t, "0.499", coinGeckoPrices[uosmoDenom][usdcDenom])
+
+	_, err = sqs.GetPrices(ctx, sqsclient.WithBaseDenom(uosmoDenom), sqsclient.WithPricingSource(sqsclient.PricingSource(9)))
+	require.ErrorIs(t, err, sqsclient.ErrInvalidOptions)
+}
+
+func TestGetPrices_ChunksLongBaseDenoms(t *testing.T) {
+	baseDenoms := make([]string, 300)
+	for i := range baseDenoms {
+		baseDenoms[i] = fmt.Sprintf("ibc/%064X", i)
+	}
+
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.LessOrEqual(t, len(r.URL.RawQuery), sqsclient.MaxPricesQueryLength)
+		require.Equal(t, usdcDenom, r.URL.Query().Get("quote"))
+
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		prices := make(map[string]map[string]string)
+		for _, baseDenom := range strings.Split(r.URL.Query().Get("base"), ",") {
+			prices[baseDenom] = map[string]string{usdcDenom: "1"}
+		}
+		_ = json.NewEncoder(w).Encode(prices)
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	prices, err := sqs.GetPrices(context.Background(), sqsclient.WithBaseDenoms(baseDenoms), sqsclient.WithQuoteDenom(usdcDenom))
+	require.NoError(t, err)
+	require.Greater(t, requests, 1)
+	require.Len(t, prices, len(baseDenoms))
+	for _, baseDenom := range baseDenoms {
+		require.Equal(t, "1", prices[baseDenom][usdcDenom])
+	}
+}
+
+func TestGetPrices_ChunkError(t *testing.T) {
+	baseDenoms := make([]string, 300)
+	for i := range baseDenoms {
+		baseDenoms[i] = fmt.Sprintf("ibc/%064X", i)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Query().Get("base"), baseDenoms[len(baseDenoms)-1]) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	sqs, err := sqsclient.Initialize(sqsclient.WithCustomURL(server.URL))
+	require.NoError(t, err)
+
+	_, err = sqs.GetPrices(context.Background(), sqsclient.WithBaseDenoms(baseDenoms))
+	var apiErr *sqsclient.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+}